@@ -0,0 +1,228 @@
+// Package checked provides integer arithmetic that detects overflow. The
+// functions on int report whether their result fits in an int; Int keeps
+// computing exactly with math/big once a value leaves the int range, so a
+// day can finish a calculation and only fail if its answer does not fit.
+package checked
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+)
+
+// ErrOverflow is returned, wrapped, for results that do not fit in an int.
+var ErrOverflow = errors.New("overflow")
+
+// Add returns a+b and whether it fits in an int.
+func Add(a, b int) (int, bool) {
+	sum := a + b
+	// Unless it wrapped around, the sum lies on the side of a that b points to.
+	return sum, (sum > a) == (b > 0)
+}
+
+// Sub returns a-b and whether it fits in an int.
+func Sub(a, b int) (int, bool) {
+	diff := a - b
+	return diff, (diff < a) == (b > 0)
+}
+
+// Mul returns a*b and whether it fits in an int.
+func Mul(a, b int) (int, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	product := a * b
+	if product/b != a || (a == -1 && b == math.MinInt) || (b == -1 && a == math.MinInt) {
+		return product, false
+	}
+	return product, true
+}
+
+// Concat returns the number whose decimal digits are those of a followed
+// by those of b, and whether it fits in an int. b must not be negative.
+func Concat(a, b int) (int, bool) {
+	if b < 0 {
+		return 0, false
+	}
+	p, ok := pow10(b)
+	if !ok {
+		return 0, false
+	}
+	shifted, ok := Mul(a, p)
+	if !ok {
+		return 0, false
+	}
+	if a < 0 {
+		return Sub(shifted, b)
+	}
+	return Add(shifted, b)
+}
+
+// Shl returns a shifted left by n bits, which is a*2^n, and whether it fits
+// in an int. n must not be negative.
+func Shl(a int, n uint) (int, bool) {
+	if a == 0 {
+		return 0, true
+	}
+	if n >= bits.UintSize-1 {
+		return 0, false
+	}
+	shifted := a << n
+	return shifted, shifted>>n == a
+}
+
+// pow10 returns the smallest power of ten greater than n, which is at
+// least 10, and whether it fits in an int.
+func pow10(n int) (int, bool) {
+	p := 10
+	for p <= n {
+		var ok bool
+		if p, ok = Mul(p, 10); !ok {
+			return 0, false
+		}
+	}
+	return p, true
+}
+
+// Int is an integer of any size. Values that fit in an int are kept as one
+// and cost little more than plain arithmetic; larger ones switch to
+// math/big. The zero value is 0.
+type Int struct {
+	small int
+	// large is set, and small unused, once the value leaves the int range.
+	large *big.Int
+}
+
+// NewInt returns n as an Int.
+func NewInt(n int) Int {
+	return Int{small: n}
+}
+
+// ParseInt reads a decimal integer of any size.
+func ParseInt(s string) (Int, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return NewInt(n), nil
+	}
+	large, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return Int{}, fmt.Errorf("invalid integer %q", s)
+	}
+	return fromBig(large), nil
+}
+
+// fromBig returns large as an Int, kept small if it fits.
+func fromBig(large *big.Int) Int {
+	if large.IsInt64() && large.Int64() >= math.MinInt && large.Int64() <= math.MaxInt {
+		return NewInt(int(large.Int64()))
+	}
+	return Int{large: large}
+}
+
+func (x Int) big() *big.Int {
+	if x.large != nil {
+		return x.large
+	}
+	return big.NewInt(int64(x.small))
+}
+
+// Add returns x+y.
+func (x Int) Add(y Int) Int {
+	if x.large == nil && y.large == nil {
+		if sum, ok := Add(x.small, y.small); ok {
+			return NewInt(sum)
+		}
+	}
+	return fromBig(new(big.Int).Add(x.big(), y.big()))
+}
+
+// Sub returns x-y.
+func (x Int) Sub(y Int) Int {
+	if x.large == nil && y.large == nil {
+		if diff, ok := Sub(x.small, y.small); ok {
+			return NewInt(diff)
+		}
+	}
+	return fromBig(new(big.Int).Sub(x.big(), y.big()))
+}
+
+// Mul returns x*y.
+func (x Int) Mul(y Int) Int {
+	if x.large == nil && y.large == nil {
+		if product, ok := Mul(x.small, y.small); ok {
+			return NewInt(product)
+		}
+	}
+	return fromBig(new(big.Int).Mul(x.big(), y.big()))
+}
+
+// Concat returns the number whose decimal digits are those of x followed
+// by those of y. It panics if y is negative.
+func (x Int) Concat(y Int) Int {
+	if y.Sign() < 0 {
+		panic("checked: concatenating a negative number")
+	}
+	if x.large == nil && y.large == nil {
+		if n, ok := Concat(x.small, y.small); ok {
+			return NewInt(n)
+		}
+	}
+	shift := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(y.String()))), nil)
+	shifted := new(big.Int).Mul(x.big(), shift)
+	if x.Sign() < 0 {
+		return fromBig(shifted.Sub(shifted, y.big()))
+	}
+	return fromBig(shifted.Add(shifted, y.big()))
+}
+
+// QuoRem returns the quotient x/y, truncated towards zero, and the
+// remainder x-q*y. It panics if y is 0.
+func (x Int) QuoRem(y Int) (q, r Int) {
+	if x.large == nil && y.large == nil && !(x.small == math.MinInt && y.small == -1) {
+		return NewInt(x.small / y.small), NewInt(x.small % y.small)
+	}
+	bq, br := new(big.Int).QuoRem(x.big(), y.big(), new(big.Int))
+	return fromBig(bq), fromBig(br)
+}
+
+// Cmp compares x and y and returns -1, 0 or +1.
+func (x Int) Cmp(y Int) int {
+	if x.large == nil && y.large == nil {
+		switch {
+		case x.small < y.small:
+			return -1
+		case x.small > y.small:
+			return 1
+		}
+		return 0
+	}
+	return x.big().Cmp(y.big())
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of x.
+func (x Int) Sign() int {
+	return x.Cmp(Int{})
+}
+
+// Int returns x as an int, or an error wrapping ErrOverflow if it does not
+// fit in one.
+func (x Int) Int() (int, error) {
+	if x.large != nil {
+		return 0, fmt.Errorf("%w: %s does not fit in an int", ErrOverflow, x.large)
+	}
+	return x.small, nil
+}
+
+func (x Int) String() string {
+	if x.large != nil {
+		return x.large.String()
+	}
+	return strconv.Itoa(x.small)
+}
+
+// MarshalJSON encodes x as a JSON number, however large.
+func (x Int) MarshalJSON() ([]byte, error) {
+	return []byte(x.String()), nil
+}
@@ -0,0 +1,142 @@
+package checked
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestIntOps(t *testing.T) {
+	tests := []struct {
+		name   string
+		op     func(a, b int) (int, bool)
+		a, b   int
+		want   int
+		wantOK bool
+	}{
+		{"add", Add, 2, 3, 5, true},
+		{"add negative", Add, -2, -3, -5, true},
+		{"add overflow", Add, math.MaxInt, 1, 0, false},
+		{"add underflow", Add, math.MinInt, -1, 0, false},
+		{"sub", Sub, 2, 3, -1, true},
+		{"sub overflow", Sub, math.MaxInt, -1, 0, false},
+		{"sub underflow", Sub, math.MinInt, 1, 0, false},
+		{"mul", Mul, -4, 5, -20, true},
+		{"mul zero", Mul, 0, math.MinInt, 0, true},
+		{"mul overflow", Mul, 1 << 32, 1 << 31, 0, false},
+		{"mul min by -1", Mul, math.MinInt, -1, 0, false},
+		{"mul -1 by min", Mul, -1, math.MinInt, 0, false},
+		{"concat", Concat, 12, 345, 12345, true},
+		{"concat zero", Concat, 15, 0, 150, true},
+		{"concat onto zero", Concat, 0, 7, 7, true},
+		{"concat negative", Concat, -12, 3, -123, true},
+		{"concat negative b", Concat, 12, -3, 0, false},
+		{"concat overflow", Concat, 1 << 40, 1 << 20, 0, false},
+		{"concat huge b", Concat, 1, math.MaxInt, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.op(tt.a, tt.b)
+			if ok != tt.wantOK || (ok && got != tt.want) {
+				t.Errorf("got %d, %v, want %d, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestShl(t *testing.T) {
+	tests := []struct {
+		a      int
+		n      uint
+		want   int
+		wantOK bool
+	}{
+		{5, 3, 40, true},
+		{-1, 62, math.MinInt / 2, true},
+		{1, 62, 1 << 62, true},
+		{1, 63, 0, false},
+		{3, 62, 0, false},
+		{0, 200, 0, true},
+	}
+	for _, tt := range tests {
+		got, ok := Shl(tt.a, tt.n)
+		if ok != tt.wantOK || (ok && got != tt.want) {
+			t.Errorf("Shl(%d, %d) = %d, %v, want %d, %v", tt.a, tt.n, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestIntFallsBackToBig(t *testing.T) {
+	x := NewInt(math.MaxInt).Add(NewInt(1))
+	if got := x.String(); got != "9223372036854775808" {
+		t.Fatalf("MaxInt+1 = %s", got)
+	}
+	if _, err := x.Int(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Int() error = %v, want ErrOverflow", err)
+	}
+
+	// Back in range, values are small again.
+	back := x.Sub(NewInt(2))
+	if n, err := back.Int(); err != nil || n != math.MaxInt-1 {
+		t.Errorf("MaxInt+1-2 = %d, %v", n, err)
+	}
+
+	product := NewInt(1 << 40).Mul(NewInt(1 << 40))
+	if got := product.String(); got != "1208925819614629174706176" {
+		t.Errorf("2^80 = %s", got)
+	}
+	q, r := product.Add(NewInt(5)).QuoRem(NewInt(1 << 40))
+	if q.String() != "1099511627776" || r.String() != "5" {
+		t.Errorf("(2^80+5) / 2^40 = %s rem %s", q, r)
+	}
+	if product.Cmp(x) != 1 || x.Cmp(product) != -1 || product.Cmp(product) != 0 {
+		t.Error("Cmp does not order 2^63 below 2^80")
+	}
+	if NewInt(-3).Sign() != -1 || (Int{}).Sign() != 0 || product.Sign() != 1 {
+		t.Error("wrong Sign")
+	}
+}
+
+func TestIntConcat(t *testing.T) {
+	tests := []struct {
+		x, y Int
+		want string
+	}{
+		{NewInt(12), NewInt(345), "12345"},
+		{NewInt(-12), NewInt(3), "-123"},
+		{NewInt(math.MaxInt), NewInt(0), "92233720368547758070"},
+		{NewInt(1), NewInt(math.MaxInt), "19223372036854775807"},
+	}
+	for _, tt := range tests {
+		if got := tt.x.Concat(tt.y).String(); got != tt.want {
+			t.Errorf("%s || %s = %s, want %s", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
+
+func TestParseInt(t *testing.T) {
+	for _, s := range []string{"0", "-42", "9223372036854775807", "123456789012345678901234567890"} {
+		x, err := ParseInt(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if x.String() != s {
+			t.Errorf("ParseInt(%q) = %s", s, x)
+		}
+	}
+	if _, err := ParseInt("12a"); err == nil {
+		t.Error("expected an error for 12a")
+	}
+}
+
+func TestIntMarshalJSON(t *testing.T) {
+	x, _ := ParseInt("123456789012345678901234567890")
+	got, err := json.Marshal(map[string]Int{"small": NewInt(-42), "large": x})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"large":123456789012345678901234567890,"small":-42}`; string(got) != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
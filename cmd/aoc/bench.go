@@ -0,0 +1,70 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/reckerp/aoc-2024/internal/bench"
+	"github.com/reckerp/aoc-2024/internal/params"
+	"github.com/reckerp/aoc-2024/registry"
+)
+
+func benchCommand(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	year := yearFlag(fs)
+	day := fs.Int("day", 0, "day to benchmark; all days of the year when omitted")
+	runs := fs.Int("runs", 5, "number of runs per day; the fastest is reported")
+	jsonPath := fs.String("json", "", "also write the report as JSON to this file")
+	csvPath := fs.String("csv", "", "also write the report as CSV to this file")
+	paramsPath := fs.String("params", params.File, "params file overriding the puzzle defaults")
+	fs.Parse(args)
+
+	cfg, err := params.Load(*paramsPath)
+	if err != nil {
+		return err
+	}
+
+	days := registry.Days(*year)
+	if *day != 0 {
+		if _, ok := registry.Lookup(*year, *day); !ok {
+			return fmt.Errorf("no solver for %d day %d", *year, *day)
+		}
+		days = []int{*day}
+	}
+
+	report := bench.Report{Time: time.Now().UTC()}
+	for _, d := range days {
+		s, _ := registry.Lookup(*year, d)
+		report.Results = append(report.Results, bench.Day(*year, d, s, registry.InputPath(*year, d), cfg.For(s, *year, d, false, nil), *runs))
+	}
+
+	if err := bench.WriteTable(os.Stdout, report); err != nil {
+		return err
+	}
+	if *jsonPath != "" {
+		if err := writeReport(*jsonPath, report, bench.WriteJSON); err != nil {
+			return err
+		}
+	}
+	if *csvPath != "" {
+		if err := writeReport(*csvPath, report, bench.WriteCSV); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeReport(path string, report bench.Report, write func(io.Writer, bench.Report) error) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(file, report); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
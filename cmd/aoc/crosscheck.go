@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"slices"
+	"time"
+
+	"github.com/reckerp/aoc-2024/gen"
+	"github.com/reckerp/aoc-2024/internal/stress"
+	"github.com/reckerp/aoc-2024/registry"
+)
+
+func crosscheckCommand(args []string) error {
+	fs := flag.NewFlagSet("crosscheck", flag.ExitOnError)
+	year := yearFlag(fs)
+	day := fs.Int("day", 0, "day to check; every day of the year with a reference solution when omitted")
+	runs := fs.Int("n", 200, "number of inputs to generate per day")
+	size := fs.Int("size", 8, "size of the largest input; sizes cycle from 1 up to it")
+	seed := fs.Uint64("seed", 1, "seed of the first input; each later one adds one")
+	timeout := fs.Duration("timeout", 10*time.Second, "time allowed to solve and check one input")
+	fs.Parse(args)
+
+	var days []int
+	for _, d := range gen.Days(*year) {
+		if s, ok := registry.Lookup(*year, d); ok && stress.HasReference(s) && (*day == 0 || *day == d) {
+			days = append(days, d)
+		}
+	}
+	if *day != 0 && !slices.Contains(days, *day) {
+		return fmt.Errorf("%d day %d has no reference solution and generator", *year, *day)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	failed := 0
+	for _, d := range days {
+		s, _ := registry.Lookup(*year, d)
+		cfg := stress.Config{Runs: *runs, MaxSize: *size, Seed: *seed, Timeout: *timeout, Check: stress.MatchesReference(s)}
+		start := time.Now()
+		report, err := stress.Run(ctx, *year, d, s, cfg)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stdout, "day %d: %d inputs, %d skipped, %d failed, %s\n", d, report.Runs, report.Skipped, len(report.Failures), time.Since(start).Round(time.Millisecond))
+		if f, ok := report.Smallest(); ok {
+			writeSmallest(os.Stdout, f)
+		}
+		failed += len(report.Failures)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d inputs failed", failed)
+	}
+	return nil
+}
+
+// writeSmallest prints a day's smallest failing input in full, as the
+// easiest one to debug.
+func writeSmallest(w io.Writer, f stress.Failure) {
+	fmt.Fprintf(w, "  smallest failure, %s: %v\n", f.Case, f.Err)
+	if len(f.Input.Params) > 0 {
+		fmt.Fprintf(w, "  params: %s\n", f.Input.Params)
+	}
+	fmt.Fprintf(w, "  replay: %s\n", replayCommand(f))
+	fmt.Fprintf(w, "%s\n", f.Input.Data)
+}
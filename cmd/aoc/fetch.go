@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/reckerp/aoc-2024/internal/site"
+	"github.com/reckerp/aoc-2024/registry"
+)
+
+func fetchCommand(args []string) error {
+	fs := flag.NewFlagSet("fetch", flag.ExitOnError)
+	year := yearFlag(fs)
+	day := fs.Int("day", 0, "day to download the input for")
+	out := fs.String("out", "", "where to write the input, or - for stdout (default the day's input.txt)")
+	baseURL := fs.String("base-url", "", "website address (default $"+site.BaseURLEnv+" or "+site.DefaultBaseURL+")")
+	fs.Parse(args)
+
+	if *day < 1 || *day > 25 {
+		return fmt.Errorf("invalid day: %d", *day)
+	}
+
+	client, err := site.NewClient()
+	if err != nil {
+		return err
+	}
+	if *baseURL != "" {
+		client.BaseURL = strings.TrimRight(*baseURL, "/")
+	}
+
+	data, err := client.Input(context.Background(), *year, *day)
+	if err != nil {
+		return err
+	}
+
+	switch *out {
+	case "-":
+		_, err = os.Stdout.Write(data)
+		return err
+	case "":
+		*out = registry.InputPath(*year, *day)
+	}
+	if err := os.MkdirAll(filepath.Dir(*out), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(*out, data, 0o644); err != nil {
+		return err
+	}
+	fmt.Printf("%d day %d: wrote %s (cached in %s)\n", *year, *day, *out, client.InputPath(*year, *day))
+	return nil
+}
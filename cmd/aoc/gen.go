@@ -0,0 +1,30 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/reckerp/aoc-2024/gen"
+)
+
+func genCommand(args []string) error {
+	fs := flag.NewFlagSet("gen", flag.ExitOnError)
+	year := yearFlag(fs)
+	day := fs.Int("day", 0, "day to generate an input for")
+	size := fs.Int("size", 10, "size of the input, such as its number of lines or the side of its grid")
+	seed := fs.Uint64("seed", 1, "random seed; the same year, day, size and seed always give the same input")
+	fs.Parse(args)
+
+	in, err := gen.Generate(*year, *day, *size, *seed)
+	if err != nil {
+		return err
+	}
+	if len(in.Params) > 0 {
+		// Params go to standard error so standard output stays a valid
+		// input that can be piped into run.
+		fmt.Fprintf(os.Stderr, "params: %s\n", in.Params)
+	}
+	_, err = os.Stdout.Write(in.Data)
+	return err
+}
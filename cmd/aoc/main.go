@@ -0,0 +1,145 @@
+// Command aoc runs the Advent of Code solvers in this repository.
+//
+// Usage:
+//
+//	aoc run [--year 2024] --day 7 [--part 2] [--input path|-] [--example] [--save] [--format text|json|ndjson]
+//	        [--params params.json] [--param name=value]...
+//	        [--cpuprofile cpu.out] [--memprofile mem.out] [--trace trace.out]
+//	        [--explain] [--explain-format text|json]
+//	aoc run [--year 2024] --all [-j 4] [--part 2] [--example] [--save] [--format text|json|ndjson] [--params params.json]
+//	aoc verify [--year 2024] [--day 7] [--answers answers.json]
+//	aoc bench [--year 2024] [--day 7] [--runs 5] [--json report.json] [--csv report.csv] [--params params.json]
+//	aoc fetch [--year 2024] --day 7 [--out path|-] [--base-url url]
+//	aoc submit [--year 2024] --day 7 --part 1 [--answer 42] [--input path|-] [--base-url url]
+//	aoc new [--year 2024] [--answers answers.json] 19
+//	aoc watch [--year 2024] --day 7 [--part 2] [--input path] [--example] [--interval 500ms]
+//	aoc serve [--year 2024] [--addr localhost:8024] [--answers answers.json] [--bench 'bench/*.json']
+//	aoc gen [--year 2024] --day 16 [--size 10] [--seed 1]
+//	aoc stress [--year 2024] [--day 16] [-n 100] [--size 20] [--seed 1] [--timeout 10s]
+//	aoc crosscheck [--year 2024] [--day 13] [-n 200] [--size 8] [--seed 1] [--timeout 10s]
+//
+// Every command works on the latest year with solvers unless --year names
+// another. The 2024 days live in dNN directories at the repository root and
+// later years in a directory of their own, such as 2025/d01, all sharing the
+// grid, search and parse packages. The first day of a new year is created
+// with its --year:
+//
+//	aoc new --year 2025 1
+//
+// The answers and params files are keyed by year and then by day. verify
+// without --day or --year checks every year.
+//
+// Some days take params besides their input, such as the size of a grid.
+// Each day defaults to the real puzzle's values, or to its example's when
+// solving the example. The params file, params.json by default, overrides
+// the real puzzle's values by year and day, and --param overrides either:
+//
+//	{"2024": {"14": {"width": 101, "height": 103, "seconds": 100}}}
+//
+// verify and serve read params.json from the repository root.
+//
+// The profiling flags of run write one file per part, named after the flag's
+// value with the day and part added, e.g. cpu.day11.part2.out. Allocation
+// profiles count from program start, so each comes with a .base profile
+// taken before the part:
+//
+//	go tool pprof -diff_base mem.day11.part2.out.base mem.day11.part2.out
+//
+// --explain writes the decisions behind each answer to stderr, one event
+// per line, as text or JSON. Every part ends with its answer, after events
+// saying which level a report dropped, which obstructions trap the guard,
+// how often each claw machine's buttons are pressed and so on:
+//
+//	day 2 part 2: removed level line=4 index=1 level=3
+//	day 13 part 1: won prize machine=1 a=80 b=40 tokens=280
+//
+// fetch and submit read the session token from $AOC_SESSION or the file
+// aoc/session in the user config directory. fetch caches inputs in the user
+// cache directory, apart for each session, so each one is downloaded only
+// once; submit keeps a log there of wrong answers and the website's
+// cooldown, and refuses to send an answer the log already rules out.
+//
+// watch polls a day's sources, test fixtures, input and the answers file,
+// and whenever one changes rebuilds the command, solves the day again and
+// compares the answers with the stored ones.
+//
+// serve starts a dashboard on a local address listing every day's answers,
+// verification status and the timing history from the bench reports, with
+// a page per day drawing its visuals. Everything it needs is built in, so it
+// works offline.
+//
+// gen writes a random but valid input for a day, the same one for the same
+// size and seed. stress solves many such inputs and reports those a day
+// fails on, each with the command that replays it:
+//
+//	aoc gen --year 2024 --day 18 --size 6 --seed 3 | aoc run --year 2024 --day 18 --input - --param bytes=15 --param size=6
+//
+// crosscheck does the same for the days that also have a slow but plainly
+// correct reference solution, comparing the answers of the two, and prints
+// the smallest input they disagree on. Reference solutions only take inputs
+// small enough for them; parts they cannot solve are not compared.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/reckerp/aoc-2024/registry"
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(args []string) error
+}
+
+var commands = []command{
+	{"run", "solve a single day", runCommand},
+	{"verify", "check solvers against the stored answers", verifyCommand},
+	{"bench", "time the parse and solve phases of each day", benchCommand},
+	{"fetch", "download a day's puzzle input", fetchCommand},
+	{"submit", "send a day's answer to the website", submitCommand},
+	{"new", "scaffold the package for a new day", newCommand},
+	{"watch", "re-run a day whenever its files change", watchCommand},
+	{"serve", "serve a local dashboard of results, timings and visuals", serveCommand},
+	{"gen", "generate a random input for a day", genCommand},
+	{"stress", "solve generated inputs until a day fails", stressCommand},
+	{"crosscheck", "compare days with their reference solutions on generated inputs", crosscheckCommand},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	for _, cmd := range commands {
+		if cmd.name == os.Args[1] {
+			if err := cmd.run(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, "Error:", err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "unknown command: %s\n", os.Args[1])
+	usage()
+	os.Exit(2)
+}
+
+// yearFlag defines the --year flag, which defaults to the latest year with
+// registered days.
+func yearFlag(fs *flag.FlagSet) *int {
+	return fs.Int("year", registry.Latest(), "year of the event")
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: aoc <command> [flags]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", cmd.name, cmd.usage)
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+
+	"github.com/reckerp/aoc-2024/internal/scaffold"
+)
+
+func newCommand(args []string) error {
+	fs := flag.NewFlagSet("new", flag.ExitOnError)
+	year := yearFlag(fs)
+	answersPath := fs.String("answers", "answers.json", "answers file to add placeholder entries to")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc new [--year 2024] [--answers answers.json] DAY")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("expected exactly one day")
+	}
+	day, err := strconv.Atoi(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("invalid day: %s", fs.Arg(0))
+	}
+
+	written, err := scaffold.New(".", *year, day, *answersPath)
+	for _, path := range written {
+		fmt.Println("wrote", path)
+	}
+	return err
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/internal/answers"
+	"github.com/reckerp/aoc-2024/internal/inputs"
+	"github.com/reckerp/aoc-2024/internal/params"
+	"github.com/reckerp/aoc-2024/internal/profile"
+	"github.com/reckerp/aoc-2024/internal/runner"
+	"github.com/reckerp/aoc-2024/registry"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+func runCommand(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	year := yearFlag(fs)
+	day := fs.Int("day", 0, "day to solve")
+	all := fs.Bool("all", false, "solve every registered day of the year")
+	jobs := fs.Int("j", 0, "number of days to solve concurrently with --all (default one per CPU)")
+	part := fs.Int("part", 0, "part to solve (1 or 2); both when omitted")
+	inputPath := fs.String("input", "", "puzzle input file, or - for stdin (default the day's input.txt)")
+	example := fs.Bool("example", false, "solve the example input embedded in the day's solver")
+	save := fs.Bool("save", false, "store the answers as accepted in the answers file")
+	answersPath := fs.String("answers", "answers.json", "stored answers file")
+	format := fs.String("format", "text", "output format: text, json (one array) or ndjson (one record per line)")
+	paramsPath := fs.String("params", params.File, "params file overriding the puzzle defaults")
+	overrides := params.Flag{}
+	fs.Var(overrides, "param", "override a param as name=value; may be repeated")
+	var prof profile.Profiler
+	fs.StringVar(&prof.CPU, "cpuprofile", "", "write a CPU profile of each part to this file, with the day and part added to its name")
+	fs.StringVar(&prof.Mem, "memprofile", "", "write an allocation profile of each part to this file, with the day and part added to its name")
+	fs.StringVar(&prof.Trace, "trace", "", "write an execution trace of each part to this file, with the day and part added to its name")
+	explainParts := fs.Bool("explain", false, "write the decisions behind each answer to stderr")
+	explainFormat := fs.String("explain-format", "text", "format of --explain: text or json (one event per line)")
+	fs.Parse(args)
+
+	write, ok := writers[*format]
+	if !ok {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+	newSink, ok := explain.Sinks[*explainFormat]
+	if !ok {
+		return fmt.Errorf("unknown explain format %q", *explainFormat)
+	}
+
+	if *save && (*example || len(overrides) > 0) {
+		return fmt.Errorf("--save cannot be combined with --example or --param")
+	}
+
+	cfg, err := params.Load(*paramsPath)
+	if err != nil {
+		return err
+	}
+
+	parts := []int{1, 2}
+	if *part != 0 {
+		parts = []int{*part}
+	}
+
+	if *all {
+		if *day != 0 || *inputPath != "" {
+			return fmt.Errorf("--all cannot be combined with --day or --input")
+		}
+		if prof.Enabled() {
+			return fmt.Errorf("profiles can only be written for a single day")
+		}
+		if len(overrides) > 0 {
+			return fmt.Errorf("--param can only be given for a single day")
+		}
+		if *explainParts {
+			return fmt.Errorf("--explain can only be used for a single day")
+		}
+		return runAll(*year, parts, *jobs, *example, *save, *answersPath, cfg, write)
+	}
+
+	s, ok := registry.Lookup(*year, *day)
+	if !ok {
+		return fmt.Errorf("no solver for %d day %d", *year, *day)
+	}
+	if *explainParts {
+		s = solver.Explaining(s, *day, newSink(os.Stderr))
+	}
+
+	loader := &inputs.Loader{Path: *inputPath, Example: *example, Config: cfg, Overrides: solver.Params(overrides)}
+	var hooks []runner.Hook
+	if prof.Enabled() {
+		hooks = append(hooks, prof.Part)
+	}
+	result := runner.Solve(*year, *day, s, parts, loader, hooks...)
+	if err := prof.Err(); err != nil {
+		return err
+	}
+	for _, path := range prof.Written {
+		fmt.Fprintln(os.Stderr, "wrote", path)
+	}
+	if *save {
+		if err := saveAnswers(*answersPath, result); err != nil {
+			return err
+		}
+	}
+
+	if write != nil {
+		if err := write(os.Stdout, []runner.Day{result}); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range result.Parts {
+		if p.Err != nil {
+			return fmt.Errorf("day %d part %d: %w", *day, p.Part, p.Err)
+		}
+		if write == nil {
+			fmt.Printf("[PART %d] %s\n", p.Part, p.Answer)
+		}
+	}
+	return nil
+}
+
+// writers maps each --format to the function printing results in it. Text
+// output is nil because it differs between one day and a summary of all.
+var writers = map[string]func(io.Writer, []runner.Day) error{
+	"text":   nil,
+	"json":   runner.WriteJSON,
+	"ndjson": runner.WriteNDJSON,
+}
+
+// runAll solves every registered day of a year on a pool of jobs workers and
+// prints a summary in day order.
+func runAll(year int, parts []int, jobs int, example, save bool, answersPath string, cfg params.Config, write func(io.Writer, []runner.Day) error) error {
+	results := runner.All(registry.Days(year), jobs, func(day int) runner.Day {
+		s, _ := registry.Lookup(year, day)
+		return runner.Solve(year, day, s, parts, &inputs.Loader{Example: example, Config: cfg})
+	})
+
+	if write == nil {
+		write = runner.WriteSummary
+	}
+	if err := write(os.Stdout, results); err != nil {
+		return err
+	}
+
+	if save {
+		for _, result := range results {
+			if err := saveAnswers(answersPath, result); err != nil {
+				return err
+			}
+		}
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.Failed() {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d days failed", failed, len(results))
+	}
+	return nil
+}
+
+// saveAnswers stores the answers of every part of a day that succeeded.
+func saveAnswers(path string, result runner.Day) error {
+	ans, err := answers.Load(path)
+	if err != nil {
+		return err
+	}
+	for _, p := range result.Parts {
+		if p.Err == nil {
+			ans.Set(result.Year, result.Day, p.Part, p.Answer)
+		}
+	}
+	return ans.Save(path)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/reckerp/aoc-2024/gen"
+	"github.com/reckerp/aoc-2024/internal/stress"
+	"github.com/reckerp/aoc-2024/registry"
+)
+
+func stressCommand(args []string) error {
+	fs := flag.NewFlagSet("stress", flag.ExitOnError)
+	year := yearFlag(fs)
+	day := fs.Int("day", 0, "day to stress; every day of the year with a generator when omitted")
+	runs := fs.Int("n", 100, "number of inputs to generate per day")
+	size := fs.Int("size", 20, "size of the largest input; sizes cycle from 1 up to it")
+	seed := fs.Uint64("seed", 1, "seed of the first input; each later one adds one")
+	timeout := fs.Duration("timeout", 10*time.Second, "time allowed to solve one input")
+	fs.Parse(args)
+
+	days := gen.Days(*year)
+	if *day != 0 {
+		if !slices.Contains(days, *day) {
+			return fmt.Errorf("no generator for %d day %d", *year, *day)
+		}
+		days = []int{*day}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	cfg := stress.Config{Runs: *runs, MaxSize: *size, Seed: *seed, Timeout: *timeout}
+	failed := 0
+	for _, d := range days {
+		s, ok := registry.Lookup(*year, d)
+		if !ok {
+			continue
+		}
+		start := time.Now()
+		report, err := stress.Run(ctx, *year, d, s, cfg)
+		reportFailures(os.Stdout, report.Failures)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stdout, "day %d: %d inputs, %d failed, %s\n", d, report.Runs, len(report.Failures), time.Since(start).Round(time.Millisecond))
+		failed += len(report.Failures)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d inputs failed", failed)
+	}
+	return nil
+}
+
+// reportFailures prints each failure with the command that replays it.
+func reportFailures(w io.Writer, failures []stress.Failure) {
+	for _, f := range failures {
+		fmt.Fprintf(w, "FAIL %s: %v\n", f.Case, f.Err)
+		fmt.Fprintf(w, "  replay: %s\n", replayCommand(f))
+	}
+}
+
+func replayCommand(f stress.Failure) string {
+	cmd := fmt.Sprintf("aoc gen --year %d --day %d --size %d --seed %d | aoc run --year %d --day %d --input -", f.Year, f.Day, f.Size, f.Seed, f.Year, f.Day)
+	var params []string
+	for _, name := range slices.Sorted(maps.Keys(f.Input.Params)) {
+		params = append(params, fmt.Sprintf("--param %s=%d", name, f.Input.Params[name]))
+	}
+	return strings.Join(append([]string{cmd}, params...), " ")
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/reckerp/aoc-2024/internal/answers"
+	"github.com/reckerp/aoc-2024/internal/inputs"
+	"github.com/reckerp/aoc-2024/internal/params"
+	"github.com/reckerp/aoc-2024/internal/runner"
+	"github.com/reckerp/aoc-2024/internal/site"
+	"github.com/reckerp/aoc-2024/registry"
+)
+
+func submitCommand(args []string) error {
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+	year := yearFlag(fs)
+	day := fs.Int("day", 0, "day to submit")
+	part := fs.Int("part", 0, "part to submit (1 or 2)")
+	answer := fs.String("answer", "", "answer to send instead of solving the input")
+	inputPath := fs.String("input", "", "puzzle input file, or - for stdin (default the day's input.txt)")
+	answersPath := fs.String("answers", "answers.json", "stored answers file, updated when the answer is correct")
+	paramsPath := fs.String("params", params.File, "params file overriding the puzzle defaults")
+	baseURL := fs.String("base-url", "", "website address (default $"+site.BaseURLEnv+" or "+site.DefaultBaseURL+")")
+	fs.Parse(args)
+
+	if *part != 1 && *part != 2 {
+		return fmt.Errorf("invalid part: %d", *part)
+	}
+
+	if *answer == "" {
+		s, ok := registry.Lookup(*year, *day)
+		if !ok {
+			return fmt.Errorf("no solver for %d day %d", *year, *day)
+		}
+		cfg, err := params.Load(*paramsPath)
+		if err != nil {
+			return err
+		}
+		result := runner.Solve(*year, *day, s, []int{*part}, &inputs.Loader{Path: *inputPath, Config: cfg})
+		p := result.Parts[0]
+		if p.Err != nil {
+			return fmt.Errorf("day %d part %d: %w", *day, *part, p.Err)
+		}
+		*answer = p.Answer
+	}
+
+	client, err := site.NewClient()
+	if err != nil {
+		return err
+	}
+	if *baseURL != "" {
+		client.BaseURL = strings.TrimRight(*baseURL, "/")
+	}
+
+	fmt.Printf("%d day %d part %d: submitting %s\n", *year, *day, *part, *answer)
+	resp, err := client.Submit(context.Background(), *year, *day, *part, *answer)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s: %s\n", resp.Verdict, resp.Message)
+	if resp.Wait > 0 {
+		fmt.Printf("next answer allowed in %s\n", resp.Wait)
+	}
+
+	switch resp.Verdict {
+	case site.Correct:
+		ans, err := answers.Load(*answersPath)
+		if err != nil {
+			return err
+		}
+		ans.Set(*year, *day, *part, *answer)
+		return ans.Save(*answersPath)
+	case site.AlreadySolved:
+		return nil
+	}
+	return fmt.Errorf("answer not accepted: %s", resp.Verdict)
+}
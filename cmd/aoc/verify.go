@@ -0,0 +1,53 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/reckerp/aoc-2024/internal/answers"
+	"github.com/reckerp/aoc-2024/internal/verify"
+)
+
+func verifyCommand(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	year := yearFlag(fs)
+	day := fs.Int("day", 0, "day to verify; all days when omitted, of every year unless --year is given")
+	answersPath := fs.String("answers", "answers.json", "stored answers file")
+	fs.Parse(args)
+
+	yearGiven := false
+	fs.Visit(func(f *flag.Flag) { yearGiven = yearGiven || f.Name == "year" })
+
+	ans, err := answers.Load(*answersPath)
+	if err != nil {
+		return err
+	}
+
+	var results []verify.Result
+	switch {
+	case *day != 0:
+		results = verify.Day(".", *year, *day, ans)
+	case yearGiven:
+		results = verify.Year(".", *year, ans)
+	default:
+		results = verify.All(".", ans)
+	}
+
+	failed := 0
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "YEAR\tDAY\tPART\tSTATUS\tDETAIL")
+	for _, r := range results {
+		fmt.Fprintf(w, "%d\t%d\t%d\t%s\t%s\n", r.Year, r.Day, r.Part, r.Status, r)
+		if r.Status == verify.Fail {
+			failed++
+		}
+	}
+	w.Flush()
+
+	if failed > 0 {
+		return fmt.Errorf("%d part(s) failed verification", failed)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/reckerp/aoc-2024/internal/answers"
+	"github.com/reckerp/aoc-2024/internal/bench"
+	"github.com/reckerp/aoc-2024/internal/params"
+	"github.com/reckerp/aoc-2024/internal/runner"
+	"github.com/reckerp/aoc-2024/internal/verify"
+	"github.com/reckerp/aoc-2024/internal/watch"
+)
+
+func watchCommand(args []string) error {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+	year := yearFlag(fs)
+	day := fs.Int("day", 0, "day to watch")
+	part := fs.Int("part", 0, "part to solve (1 or 2); both when omitted")
+	inputPath := fs.String("input", "", "puzzle input file (default the day's input.txt)")
+	example := fs.Bool("example", false, "solve the example input embedded in the day's solver instead of comparing answers")
+	answersPath := fs.String("answers", "answers.json", "stored answers file")
+	paramsPath := fs.String("params", params.File, "params file overriding the puzzle defaults")
+	overrides := params.Flag{}
+	fs.Var(overrides, "param", "override a param as name=value, without comparing answers; may be repeated")
+	interval := fs.Duration("interval", 500*time.Millisecond, "how often to check for changes")
+	fs.Parse(args)
+
+	if *day < 1 || *day > 25 {
+		return fmt.Errorf("invalid day: %d", *day)
+	}
+	if *inputPath == "-" {
+		return fmt.Errorf("watch cannot read standard input")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	tmp, err := os.MkdirTemp("", "aoc-watch-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	w := &watcher{
+		year:        *year,
+		day:         *day,
+		answersPath: *answersPath,
+		compare:     !*example && len(overrides) == 0,
+		binary:      filepath.Join(tmp, "aoc"),
+		args:        []string{"run", "--year", fmt.Sprint(*year), "--day", fmt.Sprint(*day), "--format", "json", "--params", *paramsPath},
+		out:         os.Stdout,
+	}
+	if *part != 0 {
+		w.args = append(w.args, "--part", fmt.Sprint(*part))
+	}
+	if *inputPath != "" {
+		w.args = append(w.args, "--input", *inputPath)
+	}
+	if *example {
+		w.args = append(w.args, "--example")
+	}
+	for name, value := range overrides {
+		w.args = append(w.args, "--param", fmt.Sprintf("%s=%d", name, value))
+	}
+
+	files := func() ([]string, error) {
+		return watch.DayFiles(".", *year, *day, *inputPath, *answersPath, *paramsPath)
+	}
+	w.run(ctx, nil)
+	fmt.Fprintf(os.Stdout, "watching %d day %d, press Ctrl-C to stop\n", *year, *day)
+	return watch.Poll(ctx, *interval, files, func(changed []string) { w.run(ctx, changed) })
+}
+
+// watcher rebuilds the aoc command and re-runs one day with it. Building a
+// fresh binary each time picks up edits to the day's sources, which a
+// running process could never do.
+type watcher struct {
+	year        int
+	day         int
+	answersPath string
+	// compare is unset when the example or overridden params are solved,
+	// as the stored answers are those of the real puzzle.
+	compare bool
+	binary  string
+	args    []string
+	out     io.Writer
+}
+
+func (w *watcher) run(ctx context.Context, changed []string) {
+	header := "initial run"
+	if len(changed) > 0 {
+		header = strings.Join(changed, ", ") + " changed"
+	}
+	fmt.Fprintf(w.out, "\n[%s] %s\n", time.Now().Format(time.TimeOnly), header)
+
+	start := time.Now()
+	build := exec.CommandContext(ctx, "go", "build", "-o", w.binary, "./cmd/aoc")
+	if out, err := build.CombinedOutput(); err != nil {
+		fmt.Fprintf(w.out, "build failed: %v\n%s", err, out)
+		return
+	}
+	fmt.Fprintf(w.out, "built in %s\n", bench.Round(time.Since(start)))
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, w.binary, w.args...)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	runErr := cmd.Run()
+
+	var records []runner.Record
+	if err := json.Unmarshal(stdout.Bytes(), &records); err != nil {
+		// The run failed before it could report any parts.
+		if runErr == nil {
+			runErr = err
+		}
+		fmt.Fprintf(w.out, "run failed: %v\n%s", runErr, stderr.Bytes())
+		return
+	}
+	var exitErr *exec.ExitError
+	if runErr != nil && !errors.As(runErr, &exitErr) {
+		fmt.Fprintf(w.out, "run failed: %v\n", runErr)
+		return
+	}
+
+	w.report(records)
+}
+
+// report prints each part's answer and timing and, if w.compare is set, how
+// it compares with the stored answer.
+func (w *watcher) report(records []runner.Record) {
+	ans, err := answers.Load(w.answersPath)
+	if err != nil {
+		fmt.Fprintf(w.out, "cannot compare answers: %v\n", err)
+		ans = answers.Answers{}
+	}
+
+	tw := tabwriter.NewWriter(w.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "PART\tANSWER\tSTATUS\tDETAIL\tTIME")
+	for _, rec := range records {
+		var solveErr error
+		if rec.Error != "" {
+			solveErr = errors.New(rec.Error)
+		}
+
+		status, detail := verify.Status("-"), ""
+		if w.compare {
+			r := verify.Compare(rec.Year, rec.Day, rec.Part, rec.Answer, solveErr, ans)
+			status = r.Status
+			if r.Status != verify.Pass {
+				detail = r.String()
+			}
+		} else if solveErr != nil {
+			status, detail = verify.Fail, rec.Error
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\n", rec.Part, rec.Answer, status, detail, bench.Round(rec.Duration))
+	}
+	tw.Flush()
+}
@@ -0,0 +1,111 @@
+package d01
+
+import (
+	_ "embed"
+	"io"
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+//go:embed testdata/example.txt
+var example []byte
+
+var Solver = solver.New(Parse, Part1, Part2, solver.WithExample(example), solver.WithExplain(explainPart1, explainPart2))
+
+// Input holds the two location ID lists.
+type Input struct {
+	Left, Right []int
+}
+
+func Parse(r io.Reader) (Input, error) {
+	left, right, err := readInput(r)
+	return Input{Left: left, Right: right}, err
+}
+
+func Part1(in Input) (int, error) {
+	return explainPart1(in, nil, nil)
+}
+
+func Part2(in Input) (int, error) {
+	return explainPart2(in, nil, nil)
+}
+
+func explainPart1(in Input, _ solver.Params, t *explain.Tracer) (int, error) {
+	return calculateTotalDistance(in.Left, in.Right, t), nil
+}
+
+func explainPart2(in Input, _ solver.Params, t *explain.Tracer) (int, error) {
+	return calculateSimilarityScore(in.Left, in.Right, t), nil
+}
+
+// PART 1
+
+// calculateTotalDistance pairs up the IDs of both lists in sorted order and
+// sums how far apart each pair is, tracing every pair.
+func calculateTotalDistance(left, right []int, t *explain.Tracer) int {
+	left = slices.Clone(left)
+	right = slices.Clone(right)
+	sort.Ints(left)
+	sort.Ints(right)
+
+	totalDistance := 0
+	for i := range left {
+		distance := int(math.Abs(float64(left[i] - right[i])))
+		t.Event("paired", "left", left[i], "right", right[i], "distance", distance)
+		totalDistance += distance
+	}
+
+	return totalDistance
+}
+
+// PART 2
+
+// calculateSimilarityScore sums each ID of the left list times how often it
+// appears in the right one, tracing the IDs that appear there by their line
+// in the input.
+func calculateSimilarityScore(left, right []int, t *explain.Tracer) int {
+	// Build a frequency map for the right list
+	rightFrequency := make(map[int]int)
+	for _, num := range right {
+		rightFrequency[num]++
+	}
+
+	// Calculate the similarity score
+	similarityScore := 0
+	for line, num := range left {
+		if count := rightFrequency[num]; count > 0 {
+			t.Event("similar", "line", line+1, "id", num, "count", count, "score", num*count)
+			similarityScore += num * count
+		}
+	}
+
+	return similarityScore
+}
+
+func readInput(r io.Reader) ([]int, []int, error) {
+	var left, right []int
+	scanner := parse.NewScanner(r)
+	for scanner.Scan() {
+		ids, err := scanner.Ints(scanner.Field(), "")
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(ids) != 2 {
+			return nil, nil, scanner.LineErrorf("two location IDs")
+		}
+
+		left = append(left, ids[0])
+		right = append(right, ids[1])
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return left, right, nil
+}
@@ -0,0 +1,115 @@
+package d01
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse/parsetest"
+)
+
+func parseExample(t testing.TB, name string) Input {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	input, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", name, err)
+	}
+	return input
+}
+
+func TestParts(t *testing.T) {
+	tests := []struct {
+		file string
+		part int
+		want int
+	}{
+		{"example.txt", 1, 11},
+		{"example.txt", 2, 31},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/part%d", tt.file, tt.part), func(t *testing.T) {
+			input := parseExample(t, tt.file)
+			solve := Part1
+			if tt.part == 2 {
+				solve = Part2
+			}
+			got, err := solve(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPart1DoesNotModifyInput(t *testing.T) {
+	input := parseExample(t, "example.txt")
+	if _, err := Part1(input); err != nil {
+		t.Fatal(err)
+	}
+	if input.Left[0] != 3 || input.Right[0] != 4 {
+		t.Errorf("input was modified: left[0]=%d right[0]=%d", input.Left[0], input.Right[0])
+	}
+}
+
+func TestExplain(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := explain.New(explain.Text(&buf), 1, 2)
+	if _, err := explainPart2(parseExample(t, "example.txt"), nil, tracer); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `day 1 part 2: similar line=1 id=3 count=3 score=9
+day 1 part 2: similar line=2 id=4 count=1 score=4
+day 1 part 2: similar line=5 id=3 count=3 score=9
+day 1 part 2: similar line=6 id=3 count=3 score=9
+`
+	if buf.String() != want {
+		t.Errorf("got trace\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	parsetest.Fuzz(f, Parse)
+}
+
+func BenchmarkParse(b *testing.B) {
+	data, err := os.ReadFile(filepath.Join("testdata", "example.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for range b.N {
+		if _, err := Parse(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part1(input)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part2(input)
+	}
+}
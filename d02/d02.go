@@ -0,0 +1,132 @@
+package d02
+
+import (
+	_ "embed"
+	"io"
+	"slices"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+//go:embed testdata/example.txt
+var example []byte
+
+var Solver = solver.New(Parse, Part1, Part2, solver.WithExample(example), solver.WithExplain(explainPart1, explainPart2))
+
+// Parse reads one report of levels per line.
+func Parse(r io.Reader) ([][]int, error) {
+	return readInputMatrix(r)
+}
+
+func readInputMatrix(r io.Reader) ([][]int, error) {
+	var matrix [][]int
+	scanner := parse.NewScanner(r)
+	for scanner.Scan() {
+		row, err := scanner.Ints(scanner.Field(), "")
+		if err != nil {
+			return nil, err
+		}
+		if len(row) == 0 {
+			return nil, scanner.LineErrorf("report levels")
+		}
+		matrix = append(matrix, row)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return matrix, nil
+}
+
+func isSafe(report []int) bool {
+	isIncreasing := true
+	isDecreasing := true
+
+	for i := 1; i < len(report); i++ {
+		diff := report[i] - report[i-1]
+
+		if diff > 3 || diff < -3 || diff == 0 {
+			return false
+		}
+
+		if diff > 0 {
+			isDecreasing = false
+		} else if diff < 0 {
+			isIncreasing = false
+		}
+	}
+
+	// Return true if the report is either fully increasing or fully decreasing
+	return isIncreasing || isDecreasing
+}
+
+// canBeMadeValid returns the index of the first level whose removal makes
+// the report safe, and false if there is none.
+func canBeMadeValid(report []int) (int, bool) {
+	for i := range report {
+		newSlice := make([]int, len(report))
+		copy(newSlice, report)
+
+		newSlice = slices.Delete(newSlice, i, i+1)
+
+		if isSafe(newSlice) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// sumSafeReports counts the safe reports. It traces each one by its line in
+// the input.
+func sumSafeReports(matrix [][]int, t *explain.Tracer) int {
+	sum := 0
+	for line, report := range matrix {
+		if isSafe(report) {
+			t.Event("safe report", "line", line+1)
+			sum++
+		} else {
+			t.Event("unsafe report", "line", line+1)
+		}
+	}
+
+	return sum
+}
+
+// sumSafeReportsWithDampeners counts the reports that are safe with at most
+// one level removed, tracing which one by its index in the report.
+func sumSafeReportsWithDampeners(matrix [][]int, t *explain.Tracer) int {
+	sum := 0
+	for line, report := range matrix {
+		// Check if the report is safe without any removal
+		if isSafe(report) {
+			t.Event("safe report", "line", line+1)
+			sum++
+		} else if i, ok := canBeMadeValid(report); ok {
+			t.Event("removed level", "line", line+1, "index", i, "level", report[i])
+			sum++
+		} else {
+			t.Event("unsafe report", "line", line+1)
+		}
+
+	}
+	return sum
+}
+
+func Part1(reports [][]int) (int, error) {
+	return explainPart1(reports, nil, nil)
+}
+
+func Part2(reports [][]int) (int, error) {
+	return explainPart2(reports, nil, nil)
+}
+
+func explainPart1(reports [][]int, _ solver.Params, t *explain.Tracer) (int, error) {
+	return sumSafeReports(reports, t), nil
+}
+
+func explainPart2(reports [][]int, _ solver.Params, t *explain.Tracer) (int, error) {
+	return sumSafeReportsWithDampeners(reports, t), nil
+}
@@ -0,0 +1,148 @@
+package d02
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse/parsetest"
+)
+
+func parseExample(t testing.TB, name string) [][]int {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	input, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", name, err)
+	}
+	return input
+}
+
+func TestParts(t *testing.T) {
+	tests := []struct {
+		file string
+		part int
+		want int
+	}{
+		{"example.txt", 1, 2},
+		{"example.txt", 2, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/part%d", tt.file, tt.part), func(t *testing.T) {
+			input := parseExample(t, tt.file)
+			solve := Part1
+			if tt.part == 2 {
+				solve = Part2
+			}
+			got, err := solve(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsSafe(t *testing.T) {
+	tests := []struct {
+		report []int
+		want   bool
+	}{
+		{[]int{7, 6, 4, 2, 1}, true},
+		{[]int{1, 2, 7, 8, 9}, false},
+		{[]int{9, 7, 6, 2, 1}, false},
+		{[]int{1, 3, 2, 4, 5}, false},
+		{[]int{8, 6, 4, 4, 1}, false},
+		{[]int{1, 3, 6, 7, 9}, true},
+	}
+
+	for _, tt := range tests {
+		if got := isSafe(tt.report); got != tt.want {
+			t.Errorf("isSafe(%v) = %v, want %v", tt.report, got, tt.want)
+		}
+	}
+}
+
+func TestCanBeMadeValid(t *testing.T) {
+	tests := []struct {
+		report []int
+		want   bool
+		index  int
+	}{
+		{[]int{1, 2, 7, 8, 9}, false, 0},
+		{[]int{9, 7, 6, 2, 1}, false, 0},
+		{[]int{1, 3, 2, 4, 5}, true, 1},
+		{[]int{8, 6, 4, 4, 1}, true, 2},
+		{[]int{1, 5, 6, 7, 8}, true, 0},
+		{[]int{5, 1, 2, 3, 4}, true, 0},
+	}
+
+	for _, tt := range tests {
+		if index, got := canBeMadeValid(tt.report); got != tt.want || index != tt.index {
+			t.Errorf("canBeMadeValid(%v) = %d, %v, want %d, %v", tt.report, index, got, tt.index, tt.want)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := explain.New(explain.Text(&buf), 2, 2)
+	if _, err := explainPart2(parseExample(t, "example.txt"), nil, tracer); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `day 2 part 2: safe report line=1
+day 2 part 2: unsafe report line=2
+day 2 part 2: unsafe report line=3
+day 2 part 2: removed level line=4 index=1 level=3
+day 2 part 2: removed level line=5 index=2 level=4
+day 2 part 2: safe report line=6
+`
+	if buf.String() != want {
+		t.Errorf("got trace\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	parsetest.Fuzz(f, Parse)
+}
+
+func BenchmarkParse(b *testing.B) {
+	data, err := os.ReadFile(filepath.Join("testdata", "example.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for range b.N {
+		if _, err := Parse(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part1(input)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part2(input)
+	}
+}
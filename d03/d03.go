@@ -0,0 +1,141 @@
+package d03
+
+import (
+	_ "embed"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+var (
+	//go:embed testdata/example1.txt
+	example1 []byte
+	//go:embed testdata/example2.txt
+	example2 []byte
+)
+
+var Solver = solver.New(Parse, Part1, Part2, solver.WithExamples(example1, example2), solver.WithExplain(explainPart1, explainPart2))
+
+// Parse reads the corrupted memory as a single string.
+func Parse(r io.Reader) (string, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+func findMatches(memory string, pattern string) ([][]string, error) {
+	// Compile the regex pattern
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find all matches
+	return re.FindAllStringSubmatch(memory, -1), nil
+}
+
+// part1 returns the operands of every mul instruction, tracing each one.
+func part1(memory string, t *explain.Tracer) ([][]int, error) {
+	matches, err := findMatches(memory, `mul\(\s*(\d+)[^\d]+(\d+)\s*\)`)
+	if err != nil {
+		return nil, err
+	}
+	// Prepare the result slice
+	var result [][]int
+
+	// Iterate over the matches and convert them to integers
+	for _, match := range matches {
+		if len(match) == 3 {
+			num1, err1 := strconv.Atoi(match[1])
+			num2, err2 := strconv.Atoi(match[2])
+			if err1 != nil || err2 != nil {
+				return nil, fmt.Errorf("error converting string to int: %v, %v", err1, err2)
+			}
+			t.Event("mul", "instruction", match[0], "product", num1*num2)
+			result = append(result, []int{num1, num2})
+		}
+	}
+
+	return result, nil
+}
+
+// part2 returns the operands of the mul instructions that are enabled. It
+// traces every instruction, including the skipped ones.
+func part2(memory string, t *explain.Tracer) ([][]int, error) {
+	matches, err := findMatches(memory, `(?:mul\(\s*(\d+)[^\d]+(\d+)\s*\)|don't\(\)|do\(\))`)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare the result slice
+	var result [][]int
+
+	// mul enabled
+	isEnabled := true
+
+	// Iterate over the matches and convert them to integers
+	for _, match := range matches {
+		switch match[0] {
+		case "do()":
+			t.Event("enabled", "instruction", match[0])
+			isEnabled = true
+		case "don't()":
+			t.Event("disabled", "instruction", match[0])
+			isEnabled = false
+		default:
+			if len(match) == 3 && !isEnabled {
+				t.Event("skipped mul", "instruction", match[0])
+			} else if len(match) == 3 {
+				num1, err1 := strconv.Atoi(match[1])
+				num2, err2 := strconv.Atoi(match[2])
+				if err1 != nil || err2 != nil {
+					return nil, fmt.Errorf("error converting string to int: %v, %v", err1, err2)
+				}
+				t.Event("mul", "instruction", match[0], "product", num1*num2)
+				result = append(result, []int{num1, num2})
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func Part1(memory string) (int, error) {
+	return explainPart1(memory, nil, nil)
+}
+
+func Part2(memory string) (int, error) {
+	return explainPart2(memory, nil, nil)
+}
+
+func explainPart1(memory string, _ solver.Params, t *explain.Tracer) (int, error) {
+	input, err := part1(memory, t)
+	if err != nil {
+		return 0, err
+	}
+
+	sum := 0
+	for _, match := range input {
+		sum += match[0] * match[1]
+	}
+	return sum, nil
+}
+
+func explainPart2(memory string, _ solver.Params, t *explain.Tracer) (int, error) {
+	input, err := part2(memory, t)
+	if err != nil {
+		return 0, err
+	}
+
+	sum := 0
+	for _, match := range input {
+		sum += match[0] * match[1]
+	}
+	return sum, nil
+}
@@ -0,0 +1,117 @@
+package d03
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse/parsetest"
+)
+
+func parseExample(t testing.TB, name string) string {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	input, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", name, err)
+	}
+	return input
+}
+
+func TestParts(t *testing.T) {
+	tests := []struct {
+		file string
+		part int
+		want int
+	}{
+		{"example1.txt", 1, 161},
+		{"example2.txt", 2, 48},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/part%d", tt.file, tt.part), func(t *testing.T) {
+			input := parseExample(t, tt.file)
+			solve := Part1
+			if tt.part == 2 {
+				solve = Part2
+			}
+			got, err := solve(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPart2OnlyCountsEnabledInstructions(t *testing.T) {
+	got, err := Part2("don't()mul(2,3)do()mul(4,5)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 20 {
+		t.Errorf("got %d, want 20", got)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := explain.New(explain.JSONLines(&buf), 3, 2)
+	if _, err := explainPart2(parseExample(t, "example2.txt"), nil, tracer); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"day":3,"part":2,"event":"mul","instruction":"mul(2,4)","product":8}
+{"day":3,"part":2,"event":"disabled","instruction":"don't()"}
+{"day":3,"part":2,"event":"skipped mul","instruction":"mul(5,5)"}
+{"day":3,"part":2,"event":"skipped mul","instruction":"mul(11,8)"}
+{"day":3,"part":2,"event":"enabled","instruction":"do()"}
+{"day":3,"part":2,"event":"mul","instruction":"mul(8,5)","product":40}
+`
+	if buf.String() != want {
+		t.Errorf("got trace\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	parsetest.Fuzz(f, Parse)
+}
+
+func BenchmarkParse(b *testing.B) {
+	data, err := os.ReadFile(filepath.Join("testdata", "example1.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for range b.N {
+		if _, err := Parse(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	input := parseExample(b, "example1.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part1(input)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	input := parseExample(b, "example2.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part2(input)
+	}
+}
@@ -0,0 +1,95 @@
+package d04
+
+import (
+	_ "embed"
+	"io"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/grid"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+//go:embed testdata/example.txt
+var example []byte
+
+var Solver = solver.New(Parse, Part1, Part2, solver.WithExample(example), solver.WithExplain(explainPart1, explainPart2))
+
+// Parse reads the word search as a grid of letters.
+func Parse(r io.Reader) (*grid.Grid[rune], error) {
+	return grid.Parse(r, "", grid.Rune)
+}
+
+func Part1(g *grid.Grid[rune]) (int, error) {
+	return explainPart1(g, nil, nil)
+}
+
+func Part2(g *grid.Grid[rune]) (int, error) {
+	return explainPart2(g, nil, nil)
+}
+
+// explainPart1 counts the XMAS words, tracing where each starts and the
+// direction it runs in.
+func explainPart1(g *grid.Grid[rune], _ solver.Params, t *explain.Tracer) (int, error) {
+	// Define the word to search
+	word := []rune("XMAS")
+
+	// Function to search for the word starting at p in a given direction
+	search := func(p, direction grid.Point) bool {
+		for i, want := range word {
+			if got, ok := g.Get(p.Add(direction.Scale(i))); !ok || got != want {
+				return false
+			}
+		}
+		return true
+	}
+
+	// Find all occurrences of the word in all 8 directions
+	occurrences := 0
+	for p := range g.All() {
+		for _, direction := range grid.Dirs8 {
+			if search(p, direction) {
+				t.Event("found word", "x", p.X, "y", p.Y, "dx", direction.X, "dy", direction.Y)
+				occurrences++
+			}
+		}
+	}
+
+	return occurrences, nil
+}
+
+// explainPart2 counts the crosses of two MAS words, tracing each by the A
+// in its middle.
+func explainPart2(g *grid.Grid[rune], _ solver.Params, t *explain.Tracer) (int, error) {
+	validPatterns := []string{"MAS", "SAM"}
+
+	// Function to check for "MAS" or "SAM" in a diagonal direction
+	checkDiagonal := func(start, direction grid.Point) bool {
+		word := ""
+		for i := 0; i < 3; i++ {
+			r, ok := g.Get(start.Add(direction.Scale(i)))
+			if !ok {
+				return false
+			}
+			word += string(r)
+		}
+		for _, pattern := range validPatterns {
+			if word == pattern {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Find all X-MAS patterns
+	count := 0
+	for p := range g.All() {
+		// Check diagonals for X-MAS pattern
+		if checkDiagonal(p.Add(grid.Point{X: -1, Y: -1}), grid.Point{X: 1, Y: 1}) &&
+			checkDiagonal(p.Add(grid.Point{X: 1, Y: -1}), grid.Point{X: -1, Y: 1}) {
+			t.Event("found cross", "x", p.X, "y", p.Y)
+			count++
+		}
+	}
+
+	return count, nil
+}
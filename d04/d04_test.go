@@ -0,0 +1,111 @@
+package d04
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/grid"
+	"github.com/reckerp/aoc-2024/parse/parsetest"
+)
+
+func parseExample(t testing.TB, name string) *grid.Grid[rune] {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	input, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", name, err)
+	}
+	return input
+}
+
+func TestParts(t *testing.T) {
+	tests := []struct {
+		file string
+		part int
+		want int
+	}{
+		{"example.txt", 1, 18},
+		{"example.txt", 2, 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/part%d", tt.file, tt.part), func(t *testing.T) {
+			input := parseExample(t, tt.file)
+			solve := Part1
+			if tt.part == 2 {
+				solve = Part2
+			}
+			got, err := solve(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExplain(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := explain.New(explain.Text(&buf), 4, 2)
+	if _, err := explainPart2(parseExample(t, "example.txt"), nil, tracer); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `day 4 part 2: found cross x=2 y=1
+day 4 part 2: found cross x=6 y=2
+day 4 part 2: found cross x=7 y=2
+day 4 part 2: found cross x=2 y=3
+day 4 part 2: found cross x=4 y=3
+day 4 part 2: found cross x=1 y=7
+day 4 part 2: found cross x=3 y=7
+day 4 part 2: found cross x=5 y=7
+day 4 part 2: found cross x=7 y=7
+`
+	if buf.String() != want {
+		t.Errorf("got trace\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	parsetest.Fuzz(f, Parse)
+}
+
+func BenchmarkParse(b *testing.B) {
+	data, err := os.ReadFile(filepath.Join("testdata", "example.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for range b.N {
+		if _, err := Parse(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part1(input)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part2(input)
+	}
+}
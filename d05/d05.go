@@ -0,0 +1,167 @@
+package d05
+
+import (
+	_ "embed"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+type Input struct {
+	Rules   map[int]map[int]bool
+	Updates [][]int
+}
+
+//go:embed testdata/example.txt
+var example []byte
+
+var Solver = solver.New(Parse, Part1, Part2, solver.WithExample(example), solver.WithExplain(explainPart1, explainPart2))
+
+func Part1(input Input) (int, error) {
+	return explainPart1(input, nil, nil)
+}
+
+func Part2(input Input) (int, error) {
+	return explainPart2(input, nil, nil)
+}
+
+func explainPart1(input Input, _ solver.Params, t *explain.Tracer) (int, error) {
+	validUpdates, _ := validateUpdates(input.Rules, input.Updates)
+
+	sumMedian := 0
+	for _, update := range validUpdates {
+		t.Event("valid update", "pages", update, "middle", findMedian(update))
+		sumMedian += findMedian(update)
+	}
+	return sumMedian, nil
+}
+
+func explainPart2(input Input, _ solver.Params, t *explain.Tracer) (int, error) {
+	_, invalidUpdates := validateUpdates(input.Rules, input.Updates)
+	fixedUpdates := fixInvalid(invalidUpdates, input.Rules, t)
+
+	sumMedian := 0
+	for _, update := range fixedUpdates {
+		sumMedian += findMedian(update)
+	}
+	return sumMedian, nil
+}
+
+// Parse reads the page ordering rules followed by the updates.
+func Parse(r io.Reader) (Input, error) {
+	scanner := parse.NewScanner(r)
+	input := Input{
+		Rules: make(map[int]map[int]bool),
+	}
+
+	// Read rules
+	rules, err := scanner.Section()
+	if err != nil {
+		return Input{}, err
+	}
+	for _, line := range rules {
+		before, after, ok := line.Cut("|")
+		if !ok {
+			return Input{}, scanner.Errorf(line, "ordering rule X|Y")
+		}
+		a, err := scanner.Int(before)
+		if err != nil {
+			return Input{}, err
+		}
+		b, err := scanner.Int(after)
+		if err != nil {
+			return Input{}, err
+		}
+		if input.Rules[a] == nil {
+			input.Rules[a] = make(map[int]bool)
+		}
+		input.Rules[a][b] = true
+	}
+
+	// Read updates
+	updates, err := scanner.Section()
+	if err != nil {
+		return Input{}, err
+	}
+	for _, line := range updates {
+		update, err := scanner.Ints(line, ",")
+		if err != nil {
+			return Input{}, err
+		}
+		input.Updates = append(input.Updates, update)
+	}
+
+	return input, scanner.End()
+}
+
+func validateUpdates(rules map[int]map[int]bool, updates [][]int) ([][]int, [][]int) {
+	validUpdates := [][]int{}
+	invalidUpdates := [][]int{}
+	for _, update := range updates {
+		if isValidUpdate(update, rules) {
+			validUpdates = append(validUpdates, update)
+		} else {
+			invalidUpdates = append(invalidUpdates, update)
+		}
+	}
+	return validUpdates, invalidUpdates
+}
+
+func isValidUpdate(update []int, rules map[int]map[int]bool) bool {
+	for i := 0; i < len(update); i++ {
+		for j := i + 1; j < len(update); j++ {
+			a, b := update[i], update[j]
+			if rules[b][a] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func findMedian(arr []int) int {
+	if len(arr) == 0 {
+		return 0
+	}
+	if len(arr)%2 == 1 {
+		return arr[len(arr)/2]
+	}
+	return (arr[len(arr)/2-1] + arr[len(arr)/2]) / 2
+}
+
+func fixInvalid(invalidUpdates [][]int, rules map[int]map[int]bool, t *explain.Tracer) [][]int {
+	fixedUpdates := make([][]int, len(invalidUpdates))
+	for i, update := range invalidUpdates {
+		fixedUpdates[i] = fixUpdate(update, rules, t)
+		t.Event("fixed update", "pages", fixedUpdates[i], "middle", findMedian(fixedUpdates[i]))
+	}
+	return fixedUpdates
+}
+
+// fixUpdate orders the pages of an update by the rules, tracing the order
+// before every swap and the rule that called for it.
+func fixUpdate(update []int, rules map[int]map[int]bool, t *explain.Tracer) []int {
+	fixed := make([]int, len(update))
+	copy(fixed, update)
+
+	for i := 0; i < len(fixed); i++ {
+		for j := i + 1; j < len(fixed); j++ {
+			if rules[fixed[j]][fixed[i]] {
+				if t.Enabled() {
+					t.Event("swap", "pages", slices.Clone(fixed), "first", fixed[i], "second", fixed[j], "rule", fmt.Sprintf("%d|%d", fixed[j], fixed[i]))
+				}
+				// Swap elements if they violate a rule
+				fixed[i], fixed[j] = fixed[j], fixed[i]
+				// Start over from the beginning after a swap
+				i = -1
+				break
+			}
+		}
+	}
+
+	return fixed
+}
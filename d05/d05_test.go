@@ -0,0 +1,133 @@
+package d05
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse/parsetest"
+)
+
+func parseExample(t testing.TB, name string) Input {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	input, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", name, err)
+	}
+	return input
+}
+
+func TestParts(t *testing.T) {
+	tests := []struct {
+		file string
+		part int
+		want int
+	}{
+		{"example.txt", 1, 143},
+		{"example.txt", 2, 123},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/part%d", tt.file, tt.part), func(t *testing.T) {
+			input := parseExample(t, tt.file)
+			solve := Part1
+			if tt.part == 2 {
+				solve = Part2
+			}
+			got, err := solve(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFixUpdate(t *testing.T) {
+	input := parseExample(t, "example.txt")
+
+	tests := []struct {
+		update []int
+		want   []int
+	}{
+		{[]int{75, 97, 47, 61, 53}, []int{97, 75, 47, 61, 53}},
+		{[]int{61, 13, 29}, []int{61, 29, 13}},
+		{[]int{97, 13, 75, 29, 47}, []int{97, 75, 47, 29, 13}},
+	}
+
+	for _, tt := range tests {
+		if isValidUpdate(tt.update, input.Rules) {
+			t.Errorf("isValidUpdate(%v) = true, want false", tt.update)
+		}
+		if got := fixUpdate(tt.update, input.Rules, nil); !slices.Equal(got, tt.want) {
+			t.Errorf("fixUpdate(%v) = %v, want %v", tt.update, got, tt.want)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := explain.New(explain.Text(&buf), 5, 2)
+	if _, err := explainPart2(parseExample(t, "example.txt"), nil, tracer); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `day 5 part 2: swap pages=[75 97 47 61 53] first=75 second=97 rule=97|75
+day 5 part 2: fixed update pages=[97 75 47 61 53] middle=47
+day 5 part 2: swap pages=[61 13 29] first=13 second=29 rule=29|13
+day 5 part 2: fixed update pages=[61 29 13] middle=29
+day 5 part 2: swap pages=[97 13 75 29 47] first=13 second=75 rule=75|13
+day 5 part 2: swap pages=[97 75 13 29 47] first=13 second=29 rule=29|13
+day 5 part 2: swap pages=[97 75 29 13 47] first=29 second=47 rule=47|29
+day 5 part 2: swap pages=[97 75 47 13 29] first=13 second=29 rule=29|13
+day 5 part 2: fixed update pages=[97 75 47 29 13] middle=47
+`
+	if buf.String() != want {
+		t.Errorf("got trace\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	parsetest.Fuzz(f, Parse)
+}
+
+func BenchmarkParse(b *testing.B) {
+	data, err := os.ReadFile(filepath.Join("testdata", "example.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for range b.N {
+		if _, err := Parse(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part1(input)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part2(input)
+	}
+}
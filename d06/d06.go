@@ -0,0 +1,178 @@
+package d06
+
+import (
+	_ "embed"
+	"io"
+	"strings"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/grid"
+	"github.com/reckerp/aoc-2024/parse"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+type State struct {
+	pos       grid.Point
+	direction int
+}
+
+// guardDirections maps each guard marker to its index in grid.Dirs4.
+var guardDirections = map[rune]int{
+	'^': 0, // Up
+	'>': 1, // Right
+	'v': 2, // Down
+	'<': 3, // Left
+}
+
+func nextDirection(currentIndex int) int {
+	return (currentIndex + 1) % len(grid.Dirs4)
+}
+
+//go:embed testdata/example.txt
+var example []byte
+
+var Solver = solver.New(Parse, Part1, Part2, solver.WithExample(example), solver.WithVisuals(Visualize), solver.WithExplain(explainPart1, explainPart2))
+
+// Parse reads the lab map as a grid of single-character cells.
+func Parse(r io.Reader) (*grid.Grid[rune], error) {
+	return readInput(r)
+}
+
+func Part1(matrix *grid.Grid[rune]) (int, error) {
+	return explainPart1(matrix, nil, nil)
+}
+
+func Part2(matrix *grid.Grid[rune]) (int, error) {
+	return explainPart2(matrix, nil, nil)
+}
+
+func explainPart1(matrix *grid.Grid[rune], _ solver.Params, t *explain.Tracer) (int, error) {
+	return distinctGuardPositions(matrix.Clone(), t), nil
+}
+
+func explainPart2(matrix *grid.Grid[rune], _ solver.Params, t *explain.Tracer) (int, error) {
+	return findLoopInducingObstructions(matrix, t), nil
+}
+
+// Visualize draws the lab with every position the guard visits marked X.
+func Visualize(matrix *grid.Grid[rune], _ solver.Params) ([]solver.Visual, error) {
+	route := matrix.Clone()
+	distinctGuardPositions(route, nil)
+	return []solver.Visual{{Title: "Guard route", Text: route.Render(grid.Cell)}}, nil
+}
+
+func readInput(r io.Reader) (*grid.Grid[rune], error) {
+	lines, err := parse.Grid(r, ".#^>v<")
+	if err != nil {
+		return nil, err
+	}
+
+	guards := 0
+	for y, line := range lines {
+		for x, char := range line {
+			if strings.ContainsRune("^>v<", char) {
+				guards++
+				if guards > 1 {
+					return nil, &parse.Error{Line: y + 1, Col: x + 1, Expected: "a single guard", Found: string(char)}
+				}
+			}
+		}
+	}
+	if guards == 0 {
+		return nil, parse.EOFErrorf("a guard (^, >, v or <)")
+	}
+	return grid.FromLines(lines, grid.Rune), nil
+}
+
+// distinctGuardPositions marks the guard's route with X and counts the
+// positions on it, tracing where the guard turns and which way it then
+// faces.
+func distinctGuardPositions(input *grid.Grid[rune], t *explain.Tracer) int {
+	pos, currentDirection := getGuardStart(input)
+	input.Set(pos, 'X')
+	sumPositions := 1
+
+	for {
+		next := pos.Add(grid.Dirs4[currentDirection])
+		cell, ok := input.Get(next)
+		if !ok {
+			break
+		}
+
+		if cell == '#' {
+			currentDirection = nextDirection(currentDirection)
+			t.Event("turned", "x", pos.X, "y", pos.Y, "facing", string("^>v<"[currentDirection]))
+			continue
+		} else if cell != 'X' {
+			sumPositions++
+			input.Set(next, 'X')
+		}
+		pos = next
+	}
+	return sumPositions
+}
+
+func getGuardStart(input *grid.Grid[rune]) (grid.Point, int) {
+	pos, _ := input.Find(func(r rune) bool {
+		_, ok := guardDirections[r]
+		return ok
+	})
+	return pos, guardDirections[input.At(pos)]
+}
+
+// findLoopInducingObstructions counts the empty positions where an
+// obstruction traps the guard in a loop, tracing each of them.
+func findLoopInducingObstructions(input *grid.Grid[rune], t *explain.Tracer) int {
+	start, startDirection := getGuardStart(input)
+	validObstructions := 0
+
+	for p, cell := range input.All() {
+		if cell != '.' || p == start {
+			continue
+		}
+
+		// Simulate obstruction placement
+		tempMatrix := input.Clone()
+		tempMatrix.Set(p, '#')
+
+		visitedStates := make(map[State]bool)
+		initialState := State{pos: start, direction: startDirection}
+
+		if createsLoop(tempMatrix, initialState, visitedStates) {
+			t.Event("loop obstruction", "x", p.X, "y", p.Y)
+			validObstructions++
+		}
+	}
+
+	return validObstructions
+}
+
+func createsLoop(matrix *grid.Grid[rune], initialState State, visitedStates map[State]bool) bool {
+	currentState := initialState
+
+	for {
+		// Check if we've visited this state before
+		if visitedStates[currentState] {
+			return true
+		}
+
+		// Mark the current state as visited
+		visitedStates[currentState] = true
+
+		// Calculate the next position and check bounds
+		next := currentState.pos.Add(grid.Dirs4[currentState.direction])
+		cell, ok := matrix.Get(next)
+		if !ok {
+			return false
+		}
+
+		// If the next position is an obstacle, change direction
+		if cell == '#' {
+			currentState.direction = nextDirection(currentState.direction)
+			continue
+		}
+
+		// Move to the next position
+		currentState.pos = next
+	}
+}
@@ -0,0 +1,128 @@
+package d06
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/grid"
+	"github.com/reckerp/aoc-2024/parse/parsetest"
+)
+
+func parseExample(t testing.TB, name string) *grid.Grid[rune] {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	input, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", name, err)
+	}
+	return input
+}
+
+func TestParts(t *testing.T) {
+	tests := []struct {
+		file string
+		part int
+		want int
+	}{
+		{"example.txt", 1, 41},
+		{"example.txt", 2, 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/part%d", tt.file, tt.part), func(t *testing.T) {
+			input := parseExample(t, tt.file)
+			solve := Part1
+			if tt.part == 2 {
+				solve = Part2
+			}
+			got, err := solve(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExplain(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := explain.New(explain.Text(&buf), 6, 2)
+	if _, err := explainPart2(parseExample(t, "example.txt"), nil, tracer); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `day 6 part 2: loop obstruction x=3 y=6
+day 6 part 2: loop obstruction x=6 y=7
+day 6 part 2: loop obstruction x=7 y=7
+day 6 part 2: loop obstruction x=1 y=8
+day 6 part 2: loop obstruction x=3 y=8
+day 6 part 2: loop obstruction x=7 y=9
+`
+	if buf.String() != want {
+		t.Errorf("got trace\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	parsetest.Fuzz(f, Parse)
+}
+
+func BenchmarkParse(b *testing.B) {
+	data, err := os.ReadFile(filepath.Join("testdata", "example.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for range b.N {
+		if _, err := Parse(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part1(input)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part2(input)
+	}
+}
+
+func TestVisualize(t *testing.T) {
+	input := parseExample(t, "example.txt")
+	before := input.Clone()
+
+	visuals, err := Visualize(input, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(visuals) != 1 {
+		t.Fatalf("got %d visuals, want 1", len(visuals))
+	}
+	if got := strings.Count(visuals[0].Text, "X"); got != 41 {
+		t.Errorf("marked %d positions, want 41", got)
+	}
+	if input.Render(grid.Cell) != before.Render(grid.Cell) {
+		t.Error("Visualize modified its input")
+	}
+}
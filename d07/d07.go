@@ -0,0 +1,158 @@
+package d07
+
+import (
+	_ "embed"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/reckerp/aoc-2024/checked"
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+//go:embed testdata/example.txt
+var example []byte
+
+var Solver = solver.New(Parse, Part1, Part2, solver.WithExample(example), solver.WithExplain(explainPart1, explainPart2))
+
+// Equation is a calibration equation whose operators are missing.
+type Equation struct {
+	TestValue int
+	Numbers   []int
+}
+
+// evaluateExpression applies the operators left to right. Intermediate
+// values may grow past the int range, so they are kept exactly.
+func evaluateExpression(numbers []int, operators []string) checked.Int {
+	result := checked.NewInt(numbers[0])
+	for i := 0; i < len(operators); i++ {
+		next := checked.NewInt(numbers[i+1])
+		switch operators[i] {
+		case "+":
+			result = result.Add(next)
+		case "*":
+			result = result.Mul(next)
+		case "||":
+			result = result.Concat(next)
+		}
+	}
+	return result
+}
+
+func generateOperatorCombinations(numOperators int, operators []string) [][]string {
+	var result [][]string
+	result = append(result, []string{})
+
+	for i := 0; i < numOperators; i++ {
+		var newResult [][]string
+		for _, combination := range result {
+			for _, op := range operators {
+				newCombination := append([]string(nil), combination...)
+				newCombination = append(newCombination, op)
+				newResult = append(newResult, newCombination)
+			}
+		}
+		result = newResult
+	}
+
+	return result
+}
+
+// isValidEquation returns the first combination of operators that makes
+// the numbers produce the test value, and false if there is none.
+func isValidEquation(testValue int, numbers []int, operators []string) ([]string, bool) {
+	operatorCombinations := generateOperatorCombinations(len(numbers)-1, operators)
+
+	want := checked.NewInt(testValue)
+	for _, ops := range operatorCombinations {
+		result := evaluateExpression(numbers, ops)
+		if result.Cmp(want) == 0 {
+			return ops, true
+		}
+	}
+	return nil, false
+}
+
+// formatExpression writes out the numbers with the operators between them,
+// as in "81 + 40 * 27".
+func formatExpression(numbers []int, operators []string) string {
+	var sb strings.Builder
+	sb.WriteString(strconv.Itoa(numbers[0]))
+	for i, op := range operators {
+		fmt.Fprintf(&sb, " %s %d", op, numbers[i+1])
+	}
+	return sb.String()
+}
+
+// Parse reads one equation per line.
+func Parse(r io.Reader) ([]Equation, error) {
+	var result []Equation
+	scanner := parse.NewScanner(r)
+	for scanner.Scan() {
+		value, rest, ok := scanner.Field().Cut(":")
+		if !ok {
+			return nil, scanner.LineErrorf("equation TEST: NUMBERS")
+		}
+
+		testValue, err := scanner.Int(value)
+		if err != nil {
+			return nil, err
+		}
+
+		numbers, err := scanner.Naturals(rest, "")
+		if err != nil {
+			return nil, err
+		}
+		if len(numbers) == 0 {
+			return nil, scanner.Errorf(rest, "at least one number")
+		}
+
+		result = append(result, Equation{TestValue: testValue, Numbers: numbers})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func Part1(equations []Equation) (int, error) {
+	return explainPart1(equations, nil, nil)
+}
+
+func Part2(equations []Equation) (int, error) {
+	return explainPart2(equations, nil, nil)
+}
+
+func explainPart1(equations []Equation, _ solver.Params, t *explain.Tracer) (int, error) {
+	return totalCalibrationResult(equations, []string{"+", "*"}, t)
+}
+
+func explainPart2(equations []Equation, _ solver.Params, t *explain.Tracer) (int, error) {
+	return totalCalibrationResult(equations, []string{"+", "*", "||"}, t)
+}
+
+// totalCalibrationResult adds up the test values of the equations that
+// can be made true, tracing the operators that do it for each.
+func totalCalibrationResult(equations []Equation, operators []string, t *explain.Tracer) (int, error) {
+	total := 0
+	for line, eq := range equations {
+		ops, valid := isValidEquation(eq.TestValue, eq.Numbers, operators)
+		if !valid {
+			t.Event("unsolvable", "line", line+1, "value", eq.TestValue)
+			continue
+		}
+		if t.Enabled() {
+			t.Event("solved", "line", line+1, "value", eq.TestValue, "expression", formatExpression(eq.Numbers, ops))
+		}
+		var ok bool
+		if total, ok = checked.Add(total, eq.TestValue); !ok {
+			return 0, fmt.Errorf("%w: the total calibration result does not fit in an int", checked.ErrOverflow)
+		}
+	}
+	return total, nil
+}
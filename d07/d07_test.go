@@ -0,0 +1,177 @@
+package d07
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/checked"
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse/parsetest"
+)
+
+func parseExample(t testing.TB, name string) []Equation {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	input, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", name, err)
+	}
+	return input
+}
+
+func TestParts(t *testing.T) {
+	tests := []struct {
+		file string
+		part int
+		want int
+	}{
+		{"example.txt", 1, 3749},
+		{"example.txt", 2, 11387},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/part%d", tt.file, tt.part), func(t *testing.T) {
+			input := parseExample(t, tt.file)
+			solve := Part1
+			if tt.part == 2 {
+				solve = Part2
+			}
+			got, err := solve(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidEquation(t *testing.T) {
+	tests := []struct {
+		testValue int
+		numbers   []int
+		operators []string
+		// want is the expression that makes the equation true, if any.
+		want string
+	}{
+		{190, []int{10, 19}, []string{"+", "*"}, "10 * 19"},
+		{3267, []int{81, 40, 27}, []string{"+", "*"}, "81 + 40 * 27"},
+		{156, []int{15, 6}, []string{"+", "*"}, ""},
+		{156, []int{15, 6}, []string{"+", "*", "||"}, "15 || 6"},
+		{7290, []int{6, 8, 6, 15}, []string{"+", "*", "||"}, "6 * 8 || 6 * 15"},
+		{21037, []int{9, 7, 18, 13}, []string{"+", "*", "||"}, ""},
+		// 2^32 * 2^32 wraps around to 0 in an int.
+		{0, []int{1 << 32, 1 << 32}, []string{"+", "*"}, ""},
+	}
+
+	for _, tt := range tests {
+		got := ""
+		if ops, ok := isValidEquation(tt.testValue, tt.numbers, tt.operators); ok {
+			got = formatExpression(tt.numbers, ops)
+		}
+		if got != tt.want {
+			t.Errorf("isValidEquation(%d, %v, %v) = %q, want %q", tt.testValue, tt.numbers, tt.operators, got, tt.want)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := explain.New(explain.Text(&buf), 7, 2)
+	if _, err := explainPart2(parseExample(t, "example.txt"), nil, tracer); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `day 7 part 2: solved line=1 value=190 expression="10 * 19"
+day 7 part 2: solved line=2 value=3267 expression="81 + 40 * 27"
+day 7 part 2: unsolvable line=3 value=83
+day 7 part 2: solved line=4 value=156 expression="15 || 6"
+day 7 part 2: solved line=5 value=7290 expression="6 * 8 || 6 * 15"
+day 7 part 2: unsolvable line=6 value=161011
+day 7 part 2: solved line=7 value=192 expression="17 || 8 + 14"
+day 7 part 2: unsolvable line=8 value=21037
+day 7 part 2: solved line=9 value=292 expression="11 + 6 * 16 + 20"
+`
+	if buf.String() != want {
+		t.Errorf("got trace\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestEvaluateExpression(t *testing.T) {
+	tests := []struct {
+		numbers   []int
+		operators []string
+		want      string
+	}{
+		{[]int{12, 345}, []string{"||"}, "12345"},
+		{[]int{6, 8, 6, 15}, []string{"*", "||", "*"}, "7290"},
+		{[]int{math.MaxInt, 1}, []string{"+"}, "9223372036854775808"},
+		{[]int{math.MaxInt, 9}, []string{"||"}, "92233720368547758079"},
+	}
+	for _, tt := range tests {
+		if got := evaluateExpression(tt.numbers, tt.operators).String(); got != tt.want {
+			t.Errorf("evaluateExpression(%v, %v) = %s, want %s", tt.numbers, tt.operators, got, tt.want)
+		}
+	}
+}
+
+func TestTotalOverflow(t *testing.T) {
+	equations := []Equation{
+		{TestValue: math.MaxInt, Numbers: []int{math.MaxInt}},
+		{TestValue: 1, Numbers: []int{1}},
+	}
+	if _, err := Part1(equations); !errors.Is(err, checked.ErrOverflow) {
+		t.Errorf("got %v, want an overflow error", err)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	parsetest.Fuzz(f, Parse)
+}
+
+func BenchmarkParse(b *testing.B) {
+	data, err := os.ReadFile(filepath.Join("testdata", "example.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for range b.N {
+		if _, err := Parse(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part1(input)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part2(input)
+	}
+}
+
+func BenchmarkGenerateOperatorCombinations(b *testing.B) {
+	operators := []string{"+", "*", "||"}
+	for range b.N {
+		generateOperatorCombinations(11, operators)
+	}
+}
@@ -0,0 +1,155 @@
+package d08
+
+import (
+	_ "embed"
+	"io"
+	"maps"
+	"math"
+	"slices"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/grid"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+//go:embed testdata/example.txt
+var example []byte
+
+var Solver = solver.New(Parse, Part1, Part2, solver.WithExample(example), solver.WithExplain(explainPart1, explainPart2))
+
+// mapCells lists the characters allowed on the map: empty space and the
+// frequencies antennas can be tuned to.
+const mapCells = ".0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+type Point = grid.Point
+
+type AntennaPositions map[rune][]Point
+
+func findAntennas(input *grid.Grid[rune]) AntennaPositions {
+	positions := make(AntennaPositions)
+
+	for p, cell := range input.All() {
+		if cell != '.' {
+			positions[cell] = append(positions[cell], p)
+		}
+	}
+
+	return positions
+}
+
+func generateCombinations(points []Point, size int) [][]Point {
+	if size == 0 {
+		return [][]Point{{}}
+	}
+	if len(points) == 0 {
+		return nil
+	}
+
+	var combinations [][]Point
+	head := points[0]
+	tail := points[1:]
+
+	for _, combo := range generateCombinations(tail, size-1) {
+		combinations = append(combinations, append([]Point{head}, combo...))
+	}
+	combinations = append(combinations, generateCombinations(tail, size)...)
+
+	return combinations
+}
+
+func calcMaxSteps(rows, cols, dx, dy int) int {
+	maxDim := max(rows, cols)
+	maxDelta := max(abs(dx), abs(dy))
+	if maxDelta == 0 {
+		return 1
+	}
+	return int(math.Ceil(float64(maxDim) / float64(maxDelta)))
+}
+
+func getAntinodes(input *grid.Grid[rune], p1, p2 Point, maxDistance bool) []Point {
+	delta := p2.Sub(p1)
+	var antinodes []Point
+
+	if maxDistance {
+		candidates := []Point{p1.Sub(delta), p2.Add(delta)}
+		for _, p := range candidates {
+			if input.In(p) {
+				antinodes = append(antinodes, p)
+			}
+		}
+	} else {
+		maxSteps := calcMaxSteps(input.Height(), input.Width(), delta.Y, delta.X)
+		for i := -maxSteps; i <= maxSteps; i++ {
+			candidates := []Point{p1.Sub(delta.Scale(i)), p2.Add(delta.Scale(i))}
+			for _, p := range candidates {
+				if input.In(p) {
+					antinodes = append(antinodes, p)
+				}
+			}
+		}
+	}
+
+	return antinodes
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// getAllAntinodes returns the antinodes of every pair of antennas tuned to
+// the same frequency, tracing each the first time a pair makes it. The
+// frequencies are gone through in order so that the trace is stable.
+func getAllAntinodes(input *grid.Grid[rune], maxDistance bool, t *explain.Tracer) map[Point]bool {
+	antennaPositions := findAntennas(input)
+	uniquePoints := make(map[Point]bool)
+
+	for _, frequency := range slices.Sorted(maps.Keys(antennaPositions)) {
+		positions := antennaPositions[frequency]
+		if len(positions) <= 1 {
+			continue
+		}
+
+		for _, combo := range generateCombinations(positions, 2) {
+			antinodes := getAntinodes(input, combo[0], combo[1], maxDistance)
+			for _, p := range antinodes {
+				if !uniquePoints[p] {
+					t.Event("antinode", "frequency", string(frequency), "x", p.X, "y", p.Y)
+				}
+				uniquePoints[p] = true
+			}
+		}
+	}
+
+	return uniquePoints
+}
+
+// Parse reads the antenna map as a grid of single-character cells.
+func Parse(r io.Reader) (*grid.Grid[rune], error) {
+	return grid.Parse(r, mapCells, grid.Rune)
+}
+
+func Part1(input *grid.Grid[rune]) (int, error) {
+	return explainPart1(input, nil, nil)
+}
+
+func Part2(input *grid.Grid[rune]) (int, error) {
+	return explainPart2(input, nil, nil)
+}
+
+func explainPart1(input *grid.Grid[rune], _ solver.Params, t *explain.Tracer) (int, error) {
+	return len(getAllAntinodes(input, true, t)), nil
+}
+
+func explainPart2(input *grid.Grid[rune], _ solver.Params, t *explain.Tracer) (int, error) {
+	return len(getAllAntinodes(input, false, t)), nil
+}
@@ -0,0 +1,177 @@
+package d09
+
+import (
+	_ "embed"
+	"fmt"
+	"io"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+//go:embed testdata/example.txt
+var example []byte
+
+var Solver = solver.New(Parse, Part1, Part2, solver.WithExample(example), solver.WithExplain(explainPart1, explainPart2))
+
+// Parse reads the disk map as a list of digits.
+func Parse(r io.Reader) ([]int, error) {
+	scanner := parse.NewScanner(r)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("error while reading input: %w", err)
+		}
+		return nil, parse.EOFErrorf("disk map")
+	}
+
+	line := scanner.Field()
+	if line.Text == "" {
+		return nil, scanner.LineErrorf("disk map")
+	}
+	var result []int
+	for i, char := range line.Text {
+		if char < '0' || char > '9' {
+			return nil, scanner.Errorf(parse.Field{Text: string(char), Col: i + 1}, "digit")
+		}
+		result = append(result, int(char-'0'))
+	}
+
+	return result, scanner.End()
+}
+
+func createLongFormat(digits []int) []rune {
+	var blocks []rune
+	fileID := 0
+	for i, d := range digits {
+		if i%2 == 0 {
+			// Append file blocks with current file ID
+			for j := 0; j < d; j++ {
+				blocks = append(blocks, rune('0'+fileID))
+			}
+			fileID++
+		} else {
+			// Append free space blocks
+			for j := 0; j < d; j++ {
+				blocks = append(blocks, '.')
+			}
+		}
+	}
+	return blocks
+}
+
+// compressPart1 moves blocks one at a time from the end of the disk to the
+// leftmost free space, tracing each move by the positions of the block.
+func compressPart1(input []rune, t *explain.Tracer) []rune {
+	for i := len(input) - 1; i >= 0; i-- {
+		if input[i] != '.' {
+			// Find the leftmost free space
+			for j := 0; j < i; j++ {
+				if input[j] == '.' {
+					// Move the block
+					t.Event("moved block", "id", int(input[i]-'0'), "from", i, "to", j)
+					input[j] = input[i]
+					input[i] = '.'
+					break
+				}
+			}
+		}
+	}
+	return input
+}
+
+func calcCheckSum(input []rune) int {
+	sum := 0
+	for i, val := range input {
+		if val != '.' {
+			fileID := int(val - '0')
+			sum += fileID * i
+		}
+	}
+	return sum
+}
+
+// compressPart2 moves whole files, highest ID first, to the leftmost free
+// space they fit in, tracing each move by the file's first block.
+func compressPart2(input []rune, t *explain.Tracer) []rune {
+	// Find the highest file ID
+	maxID := rune('0')
+	for _, r := range input {
+		if r != '.' && r > maxID {
+			maxID = r
+		}
+	}
+
+	// Iterate through file IDs in descending order
+	for id := maxID; id >= '0'; id-- {
+		// Find the file
+		start, end := -1, -1
+		for i, r := range input {
+			if r == id {
+				if start == -1 {
+					start = i
+				}
+				end = i
+			} else if start != -1 {
+				break
+			}
+		}
+
+		if start == -1 {
+			continue
+		}
+
+		fileSize := end - start + 1
+
+		// Find the leftmost free space that can fit the file
+		freeStart := -1
+		freeSize := 0
+		for i := 0; i < start; i++ { // Only look at space to the left of the file
+			if input[i] == '.' {
+				if freeStart == -1 {
+					freeStart = i
+				}
+				freeSize++
+				if freeSize == fileSize {
+					break
+				}
+			} else {
+				freeStart = -1
+				freeSize = 0
+			}
+		}
+
+		// Move the file if a suitable free space was found
+		if freeSize == fileSize {
+			t.Event("moved file", "id", int(id-'0'), "size", fileSize, "from", start, "to", freeStart)
+			copy(input[freeStart:freeStart+fileSize], input[start:end+1])
+			for i := start; i <= end; i++ {
+				input[i] = '.'
+			}
+		}
+	}
+
+	return input
+}
+
+func Part1(integers []int) (int, error) {
+	return explainPart1(integers, nil, nil)
+}
+
+func Part2(integers []int) (int, error) {
+	return explainPart2(integers, nil, nil)
+}
+
+// Part 1: Compress by moving individual blocks
+func explainPart1(integers []int, _ solver.Params, t *explain.Tracer) (int, error) {
+	lf := createLongFormat(integers)
+	compressed := compressPart1(lf, t)
+	return calcCheckSum(compressed), nil
+}
+
+// Part 2: Compress by moving whole files
+func explainPart2(integers []int, _ solver.Params, t *explain.Tracer) (int, error) {
+	lf := createLongFormat(integers)
+	compressed := compressPart2(lf, t)
+	return calcCheckSum(compressed), nil
+}
@@ -0,0 +1,100 @@
+package d10
+
+import (
+	_ "embed"
+	"io"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/grid"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+//go:embed testdata/example.txt
+var example []byte
+
+var Solver = solver.New(Parse, Part1, Part2, solver.WithExample(example), solver.WithExplain(explainPart1, explainPart2))
+
+func getInput(r io.Reader) (*grid.Grid[int], error) {
+	return grid.Parse(r, "0123456789", func(ch rune) int { return int(ch - '0') })
+}
+
+func dfs(p grid.Point, height int, m *grid.Grid[int], visited *grid.Grid[bool]) int {
+	if visited.At(p) || m.At(p) != height {
+		return 0
+	}
+
+	visited.Set(p, true)
+	if height == 9 {
+		return 1
+	}
+
+	count := 0
+	for next := range m.Neighbours4(p) {
+		count += dfs(next, height+1, m, visited)
+	}
+	return count
+}
+
+// calculateTrailheadScores sums how many summits each trailhead reaches,
+// tracing the score of every trailhead.
+func calculateTrailheadScores(m *grid.Grid[int], t *explain.Tracer) int {
+	totalScore := 0
+
+	for _, start := range m.FindAll(grid.Equal(0)) {
+		visited := grid.New[bool](m.Width(), m.Height())
+		score := dfs(start, 0, m, visited)
+		t.Event("trailhead", "x", start.X, "y", start.Y, "score", score)
+		totalScore += score
+	}
+	return totalScore
+}
+
+func dfsCount(p grid.Point, height int, m *grid.Grid[int]) int {
+	if m.At(p) != height {
+		return 0
+	}
+
+	if height == 9 {
+		return 1
+	}
+
+	count := 0
+	for next := range m.Neighbours4(p) {
+		count += dfsCount(next, height+1, m)
+	}
+	return count
+}
+
+// calculateTrailheadRatings sums how many distinct trails start at each
+// trailhead, tracing the rating of every trailhead.
+func calculateTrailheadRatings(m *grid.Grid[int], t *explain.Tracer) int {
+	totalRating := 0
+
+	for _, start := range m.FindAll(grid.Equal(0)) {
+		rating := dfsCount(start, 0, m)
+		t.Event("trailhead", "x", start.X, "y", start.Y, "rating", rating)
+		totalRating += rating
+	}
+	return totalRating
+}
+
+// Parse reads the topographic map as a grid of heights.
+func Parse(r io.Reader) (*grid.Grid[int], error) {
+	return getInput(r)
+}
+
+func Part1(m *grid.Grid[int]) (int, error) {
+	return explainPart1(m, nil, nil)
+}
+
+func Part2(m *grid.Grid[int]) (int, error) {
+	return explainPart2(m, nil, nil)
+}
+
+func explainPart1(m *grid.Grid[int], _ solver.Params, t *explain.Tracer) (int, error) {
+	return calculateTrailheadScores(m, t), nil
+}
+
+func explainPart2(m *grid.Grid[int], _ solver.Params, t *explain.Tracer) (int, error) {
+	return calculateTrailheadRatings(m, t), nil
+}
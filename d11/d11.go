@@ -0,0 +1,134 @@
+package d11
+
+import (
+	_ "embed"
+	"fmt"
+	"io"
+
+	"github.com/reckerp/aoc-2024/checked"
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+//go:embed testdata/example.txt
+var example []byte
+
+// Defaults are the number of times the stones blink in each part.
+var Defaults = solver.Params{"part1_blinks": 25, "part2_blinks": 75}
+
+var Solver = solver.NewWithParams(Parse, Part1, Part2, Defaults, solver.WithExample(example), solver.WithReference(referencePart1, referencePart2), solver.WithExplain(explainPart1, explainPart2))
+
+// applyRules returns what a stone turns into when it blinks. Stones are
+// kept exactly, as multiplying by 2024 can take them past the int range.
+func applyRules(stone checked.Int) []checked.Int {
+	if stone.Sign() == 0 {
+		return []checked.Int{checked.NewInt(1)}
+	}
+	strNum := stone.String()
+	if len(strNum)%2 == 0 {
+		mid := len(strNum) / 2
+		left, _ := checked.ParseInt(strNum[:mid])
+		right, _ := checked.ParseInt(strNum[mid:])
+		return []checked.Int{left, right}
+	}
+	return []checked.Int{stone.Mul(checked.NewInt(2024))}
+}
+
+// countStones returns how many stones num turns into after blinks blinks.
+// cache memoises the counts by stone and blinks left; it belongs to a single
+// calculateTotalStones call so every solve does the full work.
+func countStones(num checked.Int, blinks int, cache map[string]int) (int, error) {
+	// Base case: no blinks left
+	if blinks == 0 {
+		return 1, nil
+	}
+
+	// Check cache
+	key := fmt.Sprintf("%s,%d", num, blinks)
+	if val, exists := cache[key]; exists {
+		return val, nil
+	}
+
+	// Apply rules and recursively count resulting stones
+	result := 0
+	for _, next := range applyRules(num) {
+		count, err := countStones(next, blinks-1, cache)
+		if err != nil {
+			return 0, err
+		}
+		if result, err = addStones(result, count); err != nil {
+			return 0, err
+		}
+	}
+
+	// Cache the result
+	cache[key] = result
+	return result, nil
+}
+
+// calculateTotalStones returns how many stones the input turns into after
+// blinks blinks, tracing how many each of its stones turns into.
+func calculateTotalStones(input []int, blinks int, t *explain.Tracer) (int, error) {
+	if blinks < 0 {
+		return 0, fmt.Errorf("invalid blink count %d", blinks)
+	}
+	total := 0
+	cache := make(map[string]int)
+	for _, num := range input {
+		count, err := countStones(checked.NewInt(num), blinks, cache)
+		if err != nil {
+			return 0, err
+		}
+		t.Event("stone", "number", num, "stones", count)
+		if total, err = addStones(total, count); err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
+
+// addStones adds two counts of stones, failing if there are too many to
+// count in an int.
+func addStones(a, b int) (int, error) {
+	sum, ok := checked.Add(a, b)
+	if !ok {
+		return 0, fmt.Errorf("%w: more stones than fit in an int", checked.ErrOverflow)
+	}
+	return sum, nil
+}
+
+// Parse reads the engraved numbers on the initial stones.
+func Parse(r io.Reader) ([]int, error) {
+	var integers []int
+	scanner := parse.NewScanner(r)
+	for scanner.Scan() {
+		stones, err := scanner.Naturals(scanner.Field(), "")
+		if err != nil {
+			return nil, err
+		}
+		integers = append(integers, stones...)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading input: %w", err)
+	}
+
+	return integers, nil
+}
+
+func Part1(stones []int, p solver.Params) (int, error) {
+	return explainPart1(stones, p, nil)
+}
+
+func Part2(stones []int, p solver.Params) (int, error) {
+	return explainPart2(stones, p, nil)
+}
+
+func explainPart1(stones []int, p solver.Params, t *explain.Tracer) (int, error) {
+	return calculateTotalStones(stones, p["part1_blinks"], t)
+}
+
+func explainPart2(stones []int, p solver.Params, t *explain.Tracer) (int, error) {
+	return calculateTotalStones(stones, p["part2_blinks"], t)
+}
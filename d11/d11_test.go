@@ -0,0 +1,186 @@
+package d11
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/checked"
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse/parsetest"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+func parseExample(t testing.TB, name string) []int {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	input, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", name, err)
+	}
+	return input
+}
+
+func TestParts(t *testing.T) {
+	tests := []struct {
+		file string
+		part int
+		want int
+	}{
+		{"example.txt", 1, 55312},
+		{"example.txt", 2, 65601038650482},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/part%d", tt.file, tt.part), func(t *testing.T) {
+			input := parseExample(t, tt.file)
+			solve := Part1
+			if tt.part == 2 {
+				solve = Part2
+			}
+			got, err := solve(input, Defaults)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyRules(t *testing.T) {
+	tests := []struct {
+		stone int
+		want  []int
+	}{
+		{0, []int{1}},
+		{1, []int{2024}},
+		{10, []int{1, 0}},
+		{99, []int{9, 9}},
+		{999, []int{2021976}},
+		{1000, []int{10, 0}},
+	}
+
+	for _, tt := range tests {
+		var got []int
+		for _, stone := range applyRules(checked.NewInt(tt.stone)) {
+			n, _ := stone.Int()
+			got = append(got, n)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("applyRules(%d) = %v, want %v", tt.stone, got, tt.want)
+		}
+	}
+
+	// The product leaves the int range, so the stone is kept exactly.
+	big := applyRules(checked.NewInt(1_000_000_000_000_000_000))
+	if len(big) != 1 || big[0].String() != "2024000000000000000000" {
+		t.Errorf("applyRules(10^18) = %v, want [2024000000000000000000]", big)
+	}
+}
+
+func TestCalculateTotalStones(t *testing.T) {
+	if got, _ := calculateTotalStones([]int{0, 1, 10, 99, 999}, 1, nil); got != 7 {
+		t.Errorf("got %d, want 7", got)
+	}
+	// The count grows by about half with every blink, far past the int
+	// range after 200 of them.
+	if _, err := calculateTotalStones([]int{0}, 200, nil); !errors.Is(err, checked.ErrOverflow) {
+		t.Errorf("200 blinks: got %v, want an overflow error", err)
+	}
+}
+
+func TestNegativeBlinks(t *testing.T) {
+	// Counting down from a negative number of blinks never reaches zero.
+	if _, err := Part1([]int{125, 17}, Defaults.With(solver.Params{"part1_blinks": -1})); err == nil {
+		t.Error("Part1 with part1_blinks=-1: no error")
+	}
+	if _, err := Part2([]int{125, 17}, Defaults.With(solver.Params{"part2_blinks": -1})); err == nil {
+		t.Error("Part2 with part2_blinks=-1: no error")
+	}
+}
+
+func TestReferenceBlink(t *testing.T) {
+	tests := []struct {
+		stones []int
+		blinks int
+		want   int
+	}{
+		{[]int{0, 1, 10, 99, 999}, 1, 7},
+		{[]int{125, 17}, 6, 22},
+		{[]int{125, 17}, 25, 55312},
+		{[]int{1000000000000000000}, 1, 1},
+		{[]int{1000000000000000000}, 2, 2},
+	}
+	for _, tt := range tests {
+		got, err := referenceBlink(tt.stones, tt.blinks)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("referenceBlink(%v, %d) = %d, want %d", tt.stones, tt.blinks, got, tt.want)
+		}
+	}
+
+	if _, err := referenceBlink([]int{1}, 75); !errors.Is(err, solver.ErrNoReference) {
+		t.Errorf("75 blinks: got %v, want ErrNoReference", err)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := explain.New(explain.Text(&buf), 11, 1)
+	if _, err := explainPart1(parseExample(t, "example.txt"), Defaults, tracer); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `day 11 part 1: stone number=125 stones=19025
+day 11 part 1: stone number=17 stones=36287
+`
+	if buf.String() != want {
+		t.Errorf("got trace\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	parsetest.Fuzz(f, Parse)
+}
+
+func BenchmarkParse(b *testing.B) {
+	data, err := os.ReadFile(filepath.Join("testdata", "example.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for range b.N {
+		if _, err := Parse(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part1(input, Defaults)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part2(input, Defaults)
+	}
+}
@@ -0,0 +1,68 @@
+package d11
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/reckerp/aoc-2024/checked"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+// maxReferenceBlinks bounds the blinks the reference solution simulates, as
+// the row of stones grows by about half with every blink.
+const maxReferenceBlinks = 30
+
+// referenceBlink keeps every stone in a row and changes them one blink at a
+// time exactly as the puzzle describes, then counts them. The engravings
+// are kept as decimal text, so stones of any size are split by their
+// digits and multiplied exactly.
+func referenceBlink(stones []int, blinks int) (int, error) {
+	if blinks > maxReferenceBlinks {
+		return 0, fmt.Errorf("%w for more than %d blinks", solver.ErrNoReference, maxReferenceBlinks)
+	}
+
+	row := make([]string, len(stones))
+	for i, stone := range stones {
+		row[i] = strconv.Itoa(stone)
+	}
+	for range blinks {
+		next := make([]string, 0, 2*len(row))
+		for _, stone := range row {
+			switch {
+			case stone == "0":
+				next = append(next, "1")
+			case len(stone)%2 == 0:
+				left, right := stone[:len(stone)/2], strings.TrimLeft(stone[len(stone)/2:], "0")
+				if right == "" {
+					right = "0"
+				}
+				next = append(next, left, right)
+			default:
+				next = append(next, times2024(stone))
+			}
+		}
+		row = next
+	}
+	return len(row), nil
+}
+
+// times2024 multiplies a decimal number by 2024, in an int while it fits.
+func times2024(stone string) string {
+	if n, err := strconv.Atoi(stone); err == nil {
+		if product, ok := checked.Mul(n, 2024); ok {
+			return strconv.Itoa(product)
+		}
+	}
+	product, _ := new(big.Int).SetString(stone, 10)
+	return product.Mul(product, big.NewInt(2024)).String()
+}
+
+func referencePart1(stones []int, p solver.Params) (int, error) {
+	return referenceBlink(stones, p["part1_blinks"])
+}
+
+func referencePart2(stones []int, p solver.Params) (int, error) {
+	return referenceBlink(stones, p["part2_blinks"])
+}
@@ -0,0 +1,157 @@
+package d12
+
+import (
+	_ "embed"
+	"io"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/grid"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+type Point = grid.Point
+
+//go:embed testdata/example.txt
+var example []byte
+
+var Solver = solver.New(Parse, Part1, Part2, solver.WithExample(example), solver.WithVisuals(Visualize), solver.WithReference(referencePart1, referencePart2), solver.WithExplain(explainPart1, explainPart2))
+
+// Parse reads the garden plot map.
+func Parse(r io.Reader) (*grid.Grid[rune], error) {
+	return getInput(r)
+}
+
+func Part1(garden *grid.Grid[rune]) (int, error) {
+	return explainPart1(garden, nil, nil)
+}
+
+func Part2(garden *grid.Grid[rune]) (int, error) {
+	return explainPart2(garden, nil, nil)
+}
+
+func explainPart1(garden *grid.Grid[rune], _ solver.Params, t *explain.Tracer) (int, error) {
+	return calculateTotalPrice(garden, calculatePart1Price, t), nil
+}
+
+func explainPart2(garden *grid.Grid[rune], _ solver.Params, t *explain.Tracer) (int, error) {
+	return calculateTotalPrice(garden, calculatePart2Price, t), nil
+}
+
+// Visualize draws the garden; the dashboard colours each plant type, which
+// shows the regions.
+func Visualize(garden *grid.Grid[rune], _ solver.Params) ([]solver.Visual, error) {
+	return []solver.Visual{{Title: "Garden", Text: garden.Render(grid.Cell)}}, nil
+}
+
+func getInput(r io.Reader) (*grid.Grid[rune], error) {
+	return grid.Parse(r, "ABCDEFGHIJKLMNOPQRSTUVWXYZ", grid.Rune)
+}
+
+// calculateTotalPrice calculates the total price using pricing strategy. The
+// strategy traces each region by its first plot in reading order.
+func calculateTotalPrice(garden *grid.Grid[rune], pricingFunc func(*grid.Grid[rune], Point, map[Point]bool, *explain.Tracer) int, t *explain.Tracer) int {
+	visited := make(map[Point]bool)
+	totalPrice := 0
+
+	for point := range garden.All() {
+		if !visited[point] {
+			totalPrice += pricingFunc(garden, point, visited, t)
+		}
+	}
+	return totalPrice
+}
+
+// calculatePart1Price calculates price for Part 1 (area * perimeter)
+func calculatePart1Price(garden *grid.Grid[rune], start Point, visited map[Point]bool, t *explain.Tracer) int {
+	area, perimeter := exploreRegion(garden, start, visited)
+	t.Event("region", "plant", string(garden.At(start)), "x", start.X, "y", start.Y, "area", area, "perimeter", perimeter)
+	return area * perimeter
+}
+
+// calculatePart2Price calculates price for Part 2 (region size * region perimeter)
+func calculatePart2Price(garden *grid.Grid[rune], start Point, visited map[Point]bool, t *explain.Tracer) int {
+	plant, region := findContiguousRegion(garden, start, visited)
+	sides := calculateRegionPerimeter(region)
+	t.Event("region", "plant", string(plant), "x", start.X, "y", start.Y, "area", len(region), "sides", sides)
+	return len(region) * sides
+}
+
+// exploreRegion explores a region and calculates its area and perimeter
+func exploreRegion(garden *grid.Grid[rune], start Point, visited map[Point]bool) (int, int) {
+	queue := []Point{start}
+	typeRune := garden.At(start)
+	area, perimeter := 0, 0
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+		area++
+
+		for _, dir := range grid.Dirs4 {
+			neighbor := current.Add(dir)
+			if cell, ok := garden.Get(neighbor); ok && cell == typeRune {
+				if !visited[neighbor] {
+					queue = append(queue, neighbor)
+				}
+			} else {
+				perimeter++
+			}
+		}
+	}
+	return area, perimeter
+}
+
+// findContiguousRegion finds a contiguous region of the same type
+func findContiguousRegion(garden *grid.Grid[rune], start Point, visited map[Point]bool) (rune, map[Point]bool) {
+	cellType := garden.At(start)
+	queue := []Point{start}
+	region := make(map[Point]bool)
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+		region[current] = true
+
+		for neighbor := range garden.Neighbours4(current) {
+			if garden.At(neighbor) == cellType && !visited[neighbor] {
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+
+	return cellType, region
+}
+
+func calculateRegionPerimeter(region map[Point]bool) int {
+	perimeter := 0
+	for point := range region {
+		x, y := point.X, point.Y
+		checks := []struct{ nx, ny, x1, y1, x2, y2 int }{
+			{x + 1, y, x, y - 1, x + 1, y - 1},
+			{x - 1, y, x, y - 1, x - 1, y - 1},
+			{x, y + 1, x - 1, y, x - 1, y + 1},
+			{x, y - 1, x - 1, y, x - 1, y - 1},
+		}
+
+		for _, check := range checks {
+			neighbor := Point{X: check.nx, Y: check.ny}
+			corner1 := Point{X: check.x1, Y: check.y1}
+			corner2 := Point{X: check.x2, Y: check.y2}
+
+			if !region[neighbor] && !(region[corner1] && !region[corner2]) {
+				perimeter++
+			}
+		}
+	}
+	return perimeter
+}
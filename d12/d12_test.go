@@ -0,0 +1,169 @@
+package d12
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/grid"
+	"github.com/reckerp/aoc-2024/parse/parsetest"
+)
+
+func parseExample(t testing.TB, name string) *grid.Grid[rune] {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	input, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", name, err)
+	}
+	return input
+}
+
+func TestParts(t *testing.T) {
+	tests := []struct {
+		file string
+		part int
+		want int
+	}{
+		{"example_small.txt", 1, 140},
+		{"example_small.txt", 2, 80},
+		{"example_enclosed.txt", 1, 772},
+		{"example_enclosed.txt", 2, 436},
+		{"example_e.txt", 2, 236},
+		{"example_diagonal.txt", 2, 368},
+		{"example.txt", 1, 1930},
+		{"example.txt", 2, 1206},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/part%d", tt.file, tt.part), func(t *testing.T) {
+			input := parseExample(t, tt.file)
+			solve := Part1
+			if tt.part == 2 {
+				solve = Part2
+			}
+			got, err := solve(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateRegionPerimeter(t *testing.T) {
+	tests := []struct {
+		name  string
+		shape []string
+		sides int
+	}{
+		{"single", []string{"#"}, 4},
+		{"row", []string{"####"}, 4},
+		{"square", []string{"##", "##"}, 4},
+		{"l-shape", []string{"#.", "##"}, 6},
+		{"c-shape", []string{"##", "#.", "##"}, 8},
+		{"ring", []string{"###", "#.#", "###"}, 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			region := make(map[Point]bool)
+			for y, row := range tt.shape {
+				for x, c := range row {
+					if c == '#' {
+						region[Point{X: x, Y: y}] = true
+					}
+				}
+			}
+			if got := calculateRegionPerimeter(region); got != tt.sides {
+				t.Errorf("got %d sides, want %d", got, tt.sides)
+			}
+		})
+	}
+}
+
+func TestReference(t *testing.T) {
+	examples, err := filepath.Glob(filepath.Join("testdata", "*.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range examples {
+		t.Run(filepath.Base(name), func(t *testing.T) {
+			garden := parseExample(t, filepath.Base(name))
+			want1, _ := Part1(garden)
+			want2, _ := Part2(garden)
+			got1, _ := referencePart1(garden, nil)
+			got2, _ := referencePart2(garden, nil)
+			if got1 != want1 || got2 != want2 {
+				t.Errorf("reference gives %d and %d, parts give %d and %d", got1, got2, want1, want2)
+			}
+		})
+	}
+}
+
+func TestExplain(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := explain.New(explain.Text(&buf), 12, 2)
+	if _, err := explainPart2(parseExample(t, "example.txt"), nil, tracer); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `day 12 part 2: region plant=R x=0 y=0 area=12 sides=10
+day 12 part 2: region plant=I x=4 y=0 area=4 sides=4
+day 12 part 2: region plant=C x=6 y=0 area=14 sides=22
+day 12 part 2: region plant=F x=8 y=0 area=10 sides=12
+day 12 part 2: region plant=V x=0 y=2 area=13 sides=10
+day 12 part 2: region plant=J x=6 y=3 area=11 sides=12
+day 12 part 2: region plant=C x=7 y=4 area=1 sides=4
+day 12 part 2: region plant=E x=9 y=4 area=13 sides=8
+day 12 part 2: region plant=I x=2 y=5 area=14 sides=16
+day 12 part 2: region plant=M x=0 y=7 area=5 sides=6
+day 12 part 2: region plant=S x=4 y=8 area=3 sides=6
+`
+	if buf.String() != want {
+		t.Errorf("got trace\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	parsetest.Fuzz(f, Parse)
+}
+
+func BenchmarkParse(b *testing.B) {
+	data, err := os.ReadFile(filepath.Join("testdata", "example.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for range b.N {
+		if _, err := Parse(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part1(input)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part2(input)
+	}
+}
@@ -0,0 +1,77 @@
+package d12
+
+import (
+	"github.com/reckerp/aoc-2024/grid"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+// fence is one unit of fence: the edge of a plot on the side facing dir.
+type fence struct {
+	plot Point
+	dir  Point
+}
+
+// referencePrice prices each region by listing its fences one plot edge at
+// a time. With sides set it counts, instead of every fence, only those that
+// start a side: fences whose neighbour along the side is not fenced the
+// same way.
+func referencePrice(garden *grid.Grid[rune], sides bool) int {
+	region := make(map[Point]int)
+	var plots [][]Point
+	for p := range garden.All() {
+		if _, ok := region[p]; ok {
+			continue
+		}
+		id := len(plots)
+		plots = append(plots, nil)
+		region[p] = id
+		for stack := []Point{p}; len(stack) > 0; {
+			cur := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			plots[id] = append(plots[id], cur)
+			for n := range garden.Neighbours4(cur) {
+				if _, seen := region[n]; !seen && garden.At(n) == garden.At(p) {
+					region[n] = id
+					stack = append(stack, n)
+				}
+			}
+		}
+	}
+
+	total := 0
+	for id, area := range plots {
+		fences := make(map[fence]bool)
+		for _, p := range area {
+			for _, d := range grid.Dirs4 {
+				if n, ok := region[p.Add(d)]; !ok || n != id {
+					fences[fence{p, d}] = true
+				}
+			}
+		}
+
+		count := 0
+		for f := range fences {
+			along := Point{X: abs(f.dir.Y), Y: abs(f.dir.X)}
+			if !sides || !fences[fence{f.plot.Sub(along), f.dir}] {
+				count++
+			}
+		}
+		total += len(area) * count
+	}
+	return total
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func referencePart1(garden *grid.Grid[rune], _ solver.Params) (int, error) {
+	return referencePrice(garden, false), nil
+}
+
+func referencePart2(garden *grid.Grid[rune], _ solver.Params) (int, error) {
+	return referencePrice(garden, true), nil
+}
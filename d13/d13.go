@@ -0,0 +1,309 @@
+package d13
+
+import (
+	_ "embed"
+	"fmt"
+	"io"
+
+	"github.com/reckerp/aoc-2024/checked"
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+//go:embed testdata/example.txt
+var example []byte
+
+// Defaults holds how far part 2 moves every prize along both axes.
+var Defaults = solver.Params{"prize_offset": 10000000000000}
+
+var Solver = solver.NewWithParams(Parse, Part1, Part2, Defaults, solver.WithExample(example), solver.WithReference(referencePart1, referencePart2), solver.WithExplain(explainPart1, explainPart2))
+
+type Coordinate struct {
+	X int
+	Y int
+}
+
+type ClawMachine struct {
+	ButtonA Coordinate
+	ButtonB Coordinate
+	Prize   Coordinate
+}
+
+// Parse reads the claw machine descriptions, separated by blank lines.
+func Parse(r io.Reader) ([]ClawMachine, error) {
+	var machines []ClawMachine
+	scanner := parse.NewScanner(r)
+
+	for {
+		// Each machine is described by exactly these lines, in this order.
+		values, err := scanner.Record("Button A", "Button B", "Prize")
+		if err != nil {
+			return nil, err
+		}
+		if values == nil {
+			return machines, nil
+		}
+
+		var machine ClawMachine
+		for i, coord := range []*Coordinate{&machine.ButtonA, &machine.ButtonB, &machine.Prize} {
+			if *coord, err = parseCoordinate(scanner, values[i]); err != nil {
+				return nil, err
+			}
+		}
+		machines = append(machines, machine)
+	}
+}
+
+func parseCoordinate(scanner *parse.Scanner, f parse.Field) (Coordinate, error) {
+	parts := f.Split(", ")
+	if len(parts) != 2 {
+		return Coordinate{}, scanner.Errorf(f, "coordinate X.., Y..")
+	}
+
+	x, err := parseValue(scanner, parts[0], "X")
+	if err != nil {
+		return Coordinate{}, err
+	}
+
+	y, err := parseValue(scanner, parts[1], "Y")
+	if err != nil {
+		return Coordinate{}, err
+	}
+
+	return Coordinate{X: x, Y: y}, nil
+}
+
+// parseValue parses an axis offset such as "X+94" or "Y=5400".
+func parseValue(scanner *parse.Scanner, f parse.Field, axis string) (int, error) {
+	value, ok := f.TrimPrefix(axis)
+	if ok {
+		if v, ok := value.TrimPrefix("+"); ok {
+			return scanner.Int(v)
+		}
+		if v, ok := value.TrimPrefix("="); ok {
+			return scanner.Int(v)
+		}
+	}
+	return 0, scanner.Errorf(f, "%s+N or %s=N", axis, axis)
+}
+
+// maxPresses is how often part 1 allows each button to be pressed.
+const maxPresses = 100
+
+// The tokens it costs to press each button.
+const (
+	costA = 3
+	costB = 1
+)
+
+// solveClawMachine returns the fewest tokens that win the prize, and false
+// if it cannot be won. limit caps the presses of each button; a negative
+// limit leaves them unlimited.
+func solveClawMachine(machine ClawMachine, prizeOffset, limit int) (checked.Int, bool) {
+	a, b, ok := pressButtons(machine, prizeOffset, limit)
+	if !ok {
+		return checked.Int{}, false
+	}
+	return tokens(a, b), true
+}
+
+// tokens returns what it costs to press button A a times and B b times.
+func tokens(a, b checked.Int) checked.Int {
+	return a.Mul(checked.NewInt(costA)).Add(b.Mul(checked.NewInt(costB)))
+}
+
+// pressButtons returns how often to press each button to win the prize for
+// the fewest tokens, and false if it cannot be won.
+//
+// Unless the buttons are parallel, the presses follow from Cramer's rule.
+// It is worked out exactly, as the prize offset of part 2 takes the
+// products involved close to the int range.
+func pressButtons(machine ClawMachine, prizeOffset, limit int) (checked.Int, checked.Int, bool) {
+	// Set up the linear system
+	a11 := checked.NewInt(machine.ButtonA.X)
+	a12 := checked.NewInt(machine.ButtonB.X)
+	b1 := checked.NewInt(machine.Prize.X).Add(checked.NewInt(prizeOffset))
+	a21 := checked.NewInt(machine.ButtonA.Y)
+	a22 := checked.NewInt(machine.ButtonB.Y)
+	b2 := checked.NewInt(machine.Prize.Y).Add(checked.NewInt(prizeOffset))
+
+	// Calculate determinants
+	det := a11.Mul(a22).Sub(a12.Mul(a21))
+	detX := b1.Mul(a22).Sub(b2.Mul(a12))
+	detY := a11.Mul(b2).Sub(b1.Mul(a21))
+
+	// Parallel buttons leave many ways to reach a prize, or none
+	if det.Sign() == 0 {
+		return solveParallel(machine.ButtonA, machine.ButtonB, b1, b2, limit)
+	}
+
+	// Solve the system, which needs whole presses
+	x, remX := detX.QuoRem(det)
+	y, remY := detY.QuoRem(det)
+	if remX.Sign() != 0 || remY.Sign() != 0 || x.Sign() < 0 || y.Sign() < 0 {
+		return checked.Int{}, checked.Int{}, false
+	}
+	if limit >= 0 && (x.Cmp(checked.NewInt(limit)) > 0 || y.Cmp(checked.NewInt(limit)) > 0) {
+		return checked.Int{}, checked.Int{}, false // Needs more presses than allowed
+	}
+	return x, y, true
+}
+
+// solveParallel returns the presses of buttons a and b that win the prize
+// at (px, py) for the fewest tokens when both move the claw along the same
+// line, and false if it cannot be won. The claw then only reaches the
+// prize if it lies on that line, and one axis decides the presses: x
+// presses of a and y of b must satisfy u*x + v*y = w. Its whole solutions
+// are evenly spaced and the tokens change by the same amount from one to
+// the next, so the cheapest one lies at an end of the range that keeps the
+// presses within bounds.
+func solveParallel(a, b Coordinate, px, py checked.Int, limit int) (checked.Int, checked.Int, bool) {
+	dir := a
+	if dir == (Coordinate{}) {
+		dir = b
+	}
+	if dir == (Coordinate{}) {
+		// Neither button moves the claw
+		return checked.Int{}, checked.Int{}, px.Sign() == 0 && py.Sign() == 0
+	}
+	if px.Mul(checked.NewInt(dir.Y)).Cmp(py.Mul(checked.NewInt(dir.X))) != 0 {
+		return checked.Int{}, checked.Int{}, false // The prize is off the line
+	}
+
+	u, v, w := a.X, b.X, px
+	if dir.X == 0 {
+		u, v, w = a.Y, b.Y, py
+	}
+	g, s, t := extendedGCD(u, v)
+	q, rem := w.QuoRem(checked.NewInt(g))
+	if rem.Sign() != 0 {
+		return checked.Int{}, checked.Int{}, false
+	}
+
+	// Every solution is x = x0 + k*dx, y = y0 - k*dy for a whole k
+	x0, y0 := q.Mul(checked.NewInt(s)), q.Mul(checked.NewInt(t))
+	dx, dy := v/g, u/g
+	var k pressRange
+	k.atLeast(x0, dx, checked.NewInt(0))
+	k.atLeast(y0, -dy, checked.NewInt(0))
+	if limit >= 0 {
+		k.atLeast(neg(x0), -dx, checked.NewInt(-limit))
+		k.atLeast(neg(y0), dy, checked.NewInt(-limit))
+	}
+
+	// Presses cannot go below zero, so k is bounded on the side where the
+	// tokens fall
+	step := costA*dx - costB*dy
+	best, ok := k.lo, k.hasLo
+	if step < 0 {
+		best, ok = k.hi, k.hasHi
+	}
+	if !ok || k.empty() {
+		return checked.Int{}, checked.Int{}, false
+	}
+	return x0.Add(best.Mul(checked.NewInt(dx))), y0.Sub(best.Mul(checked.NewInt(dy))), true
+}
+
+// pressRange is the range of whole numbers k allowed by a set of
+// constraints, unbounded on a side until a constraint bounds it.
+type pressRange struct {
+	lo, hi       checked.Int
+	hasLo, hasHi bool
+	infeasible   bool
+}
+
+// atLeast narrows the range to the k with c0 + k*c >= min.
+func (r *pressRange) atLeast(c0 checked.Int, c int, min checked.Int) {
+	diff := min.Sub(c0)
+	switch {
+	case c == 0:
+		r.infeasible = r.infeasible || diff.Sign() > 0
+	case c > 0:
+		if lo := ceilDiv(diff, c); !r.hasLo || lo.Cmp(r.lo) > 0 {
+			r.lo, r.hasLo = lo, true
+		}
+	default:
+		if hi := floorDiv(diff, c); !r.hasHi || hi.Cmp(r.hi) < 0 {
+			r.hi, r.hasHi = hi, true
+		}
+	}
+}
+
+func (r *pressRange) empty() bool {
+	return r.infeasible || r.hasLo && r.hasHi && r.lo.Cmp(r.hi) > 0
+}
+
+func neg(n checked.Int) checked.Int {
+	return checked.NewInt(0).Sub(n)
+}
+
+// floorDiv returns n/d rounded down.
+func floorDiv(n checked.Int, d int) checked.Int {
+	q, r := n.QuoRem(checked.NewInt(d))
+	if r.Sign() != 0 && (r.Sign() < 0) != (d < 0) {
+		q = q.Sub(checked.NewInt(1))
+	}
+	return q
+}
+
+// ceilDiv returns n/d rounded up.
+func ceilDiv(n checked.Int, d int) checked.Int {
+	return neg(floorDiv(neg(n), d))
+}
+
+// extendedGCD returns the positive greatest common divisor g of a and b,
+// which must not both be zero, and s and t with a*s + b*t = g.
+func extendedGCD(a, b int) (g, s, t int) {
+	oldR, r := a, b
+	oldS, s := 1, 0
+	oldT, t := 0, 1
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldS, s = s, oldS-q*s
+		oldT, t = t, oldT-q*t
+	}
+	if oldR < 0 {
+		return -oldR, -oldS, -oldT
+	}
+	return oldR, oldS, oldT
+}
+
+// sumFewestTokens adds up the tokens needed to win every prize that can be
+// won, failing if the total does not fit in an int. It traces each machine
+// by its position in the input, with the presses that win its prize.
+func sumFewestTokens(machines []ClawMachine, prizeOffset, limit int, t *explain.Tracer) (int, error) {
+	var total checked.Int
+	for i, machine := range machines {
+		a, b, ok := pressButtons(machine, prizeOffset, limit)
+		if !ok {
+			t.Event("no prize", "machine", i+1)
+			continue
+		}
+		cost := tokens(a, b)
+		t.Event("won prize", "machine", i+1, "a", a, "b", b, "tokens", cost)
+		total = total.Add(cost)
+	}
+	n, err := total.Int()
+	if err != nil {
+		return 0, fmt.Errorf("fewest tokens: %w", err)
+	}
+	return n, nil
+}
+
+func Part1(machines []ClawMachine, p solver.Params) (int, error) {
+	return explainPart1(machines, p, nil)
+}
+
+func Part2(machines []ClawMachine, p solver.Params) (int, error) {
+	return explainPart2(machines, p, nil)
+}
+
+func explainPart1(machines []ClawMachine, _ solver.Params, t *explain.Tracer) (int, error) {
+	return sumFewestTokens(machines, 0, maxPresses, t)
+}
+
+func explainPart2(machines []ClawMachine, p solver.Params, t *explain.Tracer) (int, error) {
+	return sumFewestTokens(machines, p["prize_offset"], -1, t)
+}
@@ -0,0 +1,220 @@
+package d13
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/checked"
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse/parsetest"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+func parseExample(t testing.TB, name string) []ClawMachine {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	input, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", name, err)
+	}
+	return input
+}
+
+func TestParts(t *testing.T) {
+	tests := []struct {
+		file string
+		part int
+		want int
+	}{
+		{"example.txt", 1, 480},
+		{"example.txt", 2, 875318608908},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/part%d", tt.file, tt.part), func(t *testing.T) {
+			input := parseExample(t, tt.file)
+			solve := Part1
+			if tt.part == 2 {
+				solve = Part2
+			}
+			got, err := solve(input, Defaults)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Button A: X+1, Y+2\nButton B: X+3, Y+4\n", "<input>: expected Prize: ..., found end of input"},
+		{"Button A: X+1, Y+2\n\nButton B: X+3, Y+4\n", "<input>:2: expected Button B: ..., found empty line"},
+		{"Button A: X+1, Y+2\nPrize: X=5, Y=6\n", `<input>:2: expected Button B: ..., found "Prize: X=5, Y=6"`},
+		{"Button A: X+1, Y+2\nButton B: X+3, Y+4\nPrize: X=5, Y=6\nButton A: X+1, Y+2\n", `<input>:4: expected blank line, found "Button A: X+1, Y+2"`},
+		{"Button A: X+1, Y+2\nButton B: X+3, Y+4\nPrize: X=5, Y=x\n", `<input>:3:15: expected integer, found "x"`},
+		{"Button A: X+1; Y+2\nButton B: X+3, Y+4\nPrize: X=5, Y=6\n", `<input>:1:11: expected coordinate X.., Y.., found "X+1; Y+2"`},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(strings.NewReader(tt.input))
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("Parse(%q) error = %v, want %s", tt.input, err, tt.want)
+		}
+	}
+}
+
+func TestSolveClawMachine(t *testing.T) {
+	machines := parseExample(t, "example.txt")
+	want := []int{280, -1, 200, -1}
+
+	for i, machine := range machines {
+		got := -1
+		if tokens, ok := solveClawMachine(machine, 0, maxPresses); ok {
+			got, _ = tokens.Int()
+		}
+		if got != want[i] {
+			t.Errorf("machine %d: got %d tokens, want %d", i+1, got, want[i])
+		}
+	}
+}
+
+func TestSolveClawMachinePressLimit(t *testing.T) {
+	// Winning this prize takes 168 presses of A and 183 of B.
+	machine := ClawMachine{ButtonA: Coordinate{35, 5}, ButtonB: Coordinate{78, 47}, Prize: Coordinate{20154, 9441}}
+	if got, ok := solveClawMachine(machine, 0, maxPresses); ok {
+		t.Errorf("with the press limit: got %s tokens, want none", got)
+	}
+	if got, ok := solveClawMachine(machine, 0, -1); !ok || got.Cmp(checked.NewInt(3*168+183)) != 0 {
+		t.Errorf("without a limit: got %s tokens, want %d", got, 3*168+183)
+	}
+}
+
+func TestSolveClawMachineParallel(t *testing.T) {
+	tests := []struct {
+		a, b, prize Coordinate
+		limit       int
+		want        int
+	}{
+		{Coordinate{2, 4}, Coordinate{1, 2}, Coordinate{10, 20}, maxPresses, 10},
+		{Coordinate{2, 4}, Coordinate{1, 2}, Coordinate{300, 600}, maxPresses, 400},
+		{Coordinate{2, 4}, Coordinate{1, 2}, Coordinate{300, 600}, -1, 300},
+		{Coordinate{2, 4}, Coordinate{1, 2}, Coordinate{10, 21}, maxPresses, -1},
+		{Coordinate{3, 3}, Coordinate{5, 5}, Coordinate{7, 7}, maxPresses, -1},
+		{Coordinate{3, 3}, Coordinate{5, 5}, Coordinate{8, 8}, maxPresses, 4},
+		{Coordinate{9, 3}, Coordinate{3, 1}, Coordinate{30, 10}, maxPresses, 10},
+		{Coordinate{7, 2}, Coordinate{21, 6}, Coordinate{42, 12}, maxPresses, 2},
+		{Coordinate{0, 0}, Coordinate{1, 2}, Coordinate{10, 20}, maxPresses, 10},
+		{Coordinate{0, 3}, Coordinate{0, 1}, Coordinate{0, 9}, maxPresses, 9},
+	}
+
+	for _, tt := range tests {
+		machine := ClawMachine{ButtonA: tt.a, ButtonB: tt.b, Prize: tt.prize}
+		got := -1
+		if tokens, ok := solveClawMachine(machine, 0, tt.limit); ok {
+			got, _ = tokens.Int()
+		}
+		if got != tt.want {
+			t.Errorf("%+v with limit %d: got %d tokens, want %d", machine, tt.limit, got, tt.want)
+		}
+		if ref, err := referenceTokens(machine, 0, tt.limit); err == nil && ref != got {
+			t.Errorf("%+v with limit %d: reference gives %d tokens, solver %d", machine, tt.limit, ref, got)
+		}
+	}
+}
+
+func TestSumFewestTokensOverflow(t *testing.T) {
+	// A prize this far away takes 4*10^18 tokens to win, so three of them
+	// overflow the total.
+	machine := ClawMachine{ButtonA: Coordinate{1, 0}, ButtonB: Coordinate{0, 1}, Prize: Coordinate{0, 0}}
+	machines := []ClawMachine{machine, machine, machine}
+	if _, err := sumFewestTokens(machines, 1_000_000_000_000_000_000, -1, nil); !errors.Is(err, checked.ErrOverflow) {
+		t.Errorf("got %v, want an overflow error", err)
+	}
+}
+
+func TestReference(t *testing.T) {
+	machines := parseExample(t, "example.txt")
+	for _, offset := range []int{0, 7, 5000} {
+		p := Defaults.With(solver.Params{"prize_offset": offset})
+		want, _ := Part2(machines, p)
+		got, err := referencePart2(machines, p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("prize_offset=%d: reference gives %d, Part2 %d", offset, got, want)
+		}
+	}
+	if got, _ := referencePart1(machines, Defaults); got != 480 {
+		t.Errorf("reference part 1 = %d, want 480", got)
+	}
+	if _, err := referencePart2(machines, Defaults); !errors.Is(err, solver.ErrNoReference) {
+		t.Errorf("reference part 2 with the real offset: got %v, want ErrNoReference", err)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := explain.New(explain.Text(&buf), 13, 1)
+	if _, err := explainPart1(parseExample(t, "example.txt"), Defaults, tracer); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `day 13 part 1: won prize machine=1 a=80 b=40 tokens=280
+day 13 part 1: no prize machine=2
+day 13 part 1: won prize machine=3 a=38 b=86 tokens=200
+day 13 part 1: no prize machine=4
+`
+	if buf.String() != want {
+		t.Errorf("got trace\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	parsetest.Fuzz(f, Parse)
+}
+
+func BenchmarkParse(b *testing.B) {
+	data, err := os.ReadFile(filepath.Join("testdata", "example.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for range b.N {
+		if _, err := Parse(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part1(input, Defaults)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part2(input, Defaults)
+	}
+}
@@ -0,0 +1,69 @@
+package d13
+
+import (
+	"fmt"
+
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+// maxReferencePresses bounds the presses of button A the reference solution
+// tries, as it tries them one at a time.
+const maxReferencePresses = 1 << 24
+
+// referenceTokens tries every number of presses of button A, up to limit,
+// and works out the presses of button B that would leave the claw on the
+// prize. It returns the fewest tokens that win the prize, or -1 if it
+// cannot be won. A negative limit leaves the presses unlimited.
+func referenceTokens(machine ClawMachine, prizeOffset, limit int) (int, error) {
+	a, b := machine.ButtonA, machine.ButtonB
+	px, py := machine.Prize.X+prizeOffset, machine.Prize.Y+prizeOffset
+
+	if a.X <= 0 || b.X <= 0 {
+		return 0, fmt.Errorf("%w for buttons that do not move the claw right", solver.ErrNoReference)
+	}
+	maxA := px / a.X
+	if limit >= 0 {
+		maxA = min(maxA, limit)
+	}
+	if maxA > maxReferencePresses {
+		return 0, fmt.Errorf("%w for prizes over %d presses away", solver.ErrNoReference, maxReferencePresses)
+	}
+
+	best := -1
+	for pressA := 0; pressA <= maxA; pressA++ {
+		restX, restY := px-pressA*a.X, py-pressA*a.Y
+		if restX%b.X != 0 || restY < 0 {
+			continue
+		}
+		pressB := restX / b.X
+		if pressB*b.Y != restY || (limit >= 0 && pressB > limit) {
+			continue
+		}
+		if tokens := 3*pressA + pressB; best < 0 || tokens < best {
+			best = tokens
+		}
+	}
+	return best, nil
+}
+
+func referenceSum(machines []ClawMachine, prizeOffset, limit int) (int, error) {
+	total := 0
+	for _, machine := range machines {
+		tokens, err := referenceTokens(machine, prizeOffset, limit)
+		if err != nil {
+			return 0, err
+		}
+		if tokens >= 0 {
+			total += tokens
+		}
+	}
+	return total, nil
+}
+
+func referencePart1(machines []ClawMachine, _ solver.Params) (int, error) {
+	return referenceSum(machines, 0, maxPresses)
+}
+
+func referencePart2(machines []ClawMachine, p solver.Params) (int, error) {
+	return referenceSum(machines, p["prize_offset"], -1)
+}
@@ -0,0 +1,191 @@
+package d14
+
+import (
+	_ "embed"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+// Defaults are the size of the robots' field and how many seconds part 1
+// lets them move for. The example's are in testdata/example.params.json.
+var Defaults = solver.Params{"width": 101, "height": 103, "seconds": 100}
+
+type Robot struct {
+	X, Y, VX, VY int
+}
+
+// Field is the size of the area the robots move in.
+type Field struct {
+	Width, Height int
+}
+
+func fieldOf(p solver.Params) (Field, error) {
+	field := Field{Width: p["width"], Height: p["height"]}
+	if field.Width <= 0 || field.Height <= 0 {
+		return Field{}, fmt.Errorf("invalid field size %dx%d", field.Width, field.Height)
+	}
+	return field, nil
+}
+
+var (
+	//go:embed testdata/example.txt
+	example []byte
+	//go:embed testdata/example.params.json
+	exampleParams []byte
+)
+
+var Solver = solver.NewWithParams(Parse, Part1, Part2, Defaults, solver.WithExample(example), solver.WithExampleParams(exampleParams), solver.WithExplain(explainPart1, explainPart2))
+
+// Parse reads the position and velocity of each robot.
+func Parse(r io.Reader) ([]Robot, error) {
+	var robots []Robot
+	scanner := parse.NewScanner(r)
+
+	for scanner.Scan() {
+		parts := scanner.Field().Fields()
+		if len(parts) != 2 {
+			return nil, scanner.LineErrorf("p=X,Y v=X,Y")
+		}
+
+		x, y, err := parsePair(scanner, parts[0], "p=")
+		if err != nil {
+			return nil, err
+		}
+		vx, vy, err := parsePair(scanner, parts[1], "v=")
+		if err != nil {
+			return nil, err
+		}
+
+		robots = append(robots, Robot{X: x, Y: y, VX: vx, VY: vy})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading input: %v", err)
+	}
+
+	return robots, nil
+}
+
+// parsePair parses a field such as "p=0,4" into its two integers.
+func parsePair(scanner *parse.Scanner, f parse.Field, prefix string) (int, int, error) {
+	pair, ok := f.TrimPrefix(prefix)
+	if !ok {
+		return 0, 0, scanner.Errorf(f, "%sX,Y", prefix)
+	}
+
+	values, err := scanner.Ints(pair, ",")
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(values) != 2 {
+		return 0, 0, scanner.Errorf(f, "%sX,Y", prefix)
+	}
+	return values[0], values[1], nil
+}
+
+func moveRobot(robot Robot, field Field) Robot {
+	return Robot{
+		X:  ((robot.X+robot.VX)%field.Width + field.Width) % field.Width,
+		Y:  ((robot.Y+robot.VY)%field.Height + field.Height) % field.Height,
+		VX: robot.VX,
+		VY: robot.VY,
+	}
+}
+
+func simulateRobotIterations(robots []Robot, field Field, times int) []Robot {
+	for i := 0; i < times; i++ {
+		for j := range robots {
+			robots[j] = moveRobot(robots[j], field)
+		}
+	}
+	return robots
+}
+
+// calcSecurityLevel multiplies the numbers of robots in each quadrant,
+// tracing how many there are in each: top left, top right, bottom left and
+// bottom right.
+func calcSecurityLevel(robots []Robot, field Field, t *explain.Tracer) int {
+	midX, midY := field.Width/2, field.Height/2
+	quadrants := make([]int, 4)
+	for _, robot := range robots {
+		if robot.X != midX && robot.Y != midY {
+			if robot.X < midX && robot.Y < midY {
+				quadrants[0]++
+			} else if robot.X > midX && robot.Y < midY {
+				quadrants[1]++
+			} else if robot.X < midX && robot.Y > midY {
+				quadrants[2]++
+			} else if robot.X > midX && robot.Y > midY {
+				quadrants[3]++
+			}
+		}
+	}
+	for i, count := range quadrants {
+		t.Event("quadrant", "index", i, "robots", count)
+	}
+	return quadrants[0] * quadrants[1] * quadrants[2] * quadrants[3]
+}
+
+func robotDensity(robots []Robot) float64 {
+	sum := 0.0
+	count := 0
+	for i := 0; i < len(robots)-1; i++ {
+		for j := i + 1; j < len(robots); j++ {
+			dx := float64(robots[i].X - robots[j].X)
+			dy := float64(robots[i].Y - robots[j].Y)
+			sum += math.Sqrt(dx*dx + dy*dy)
+			count++
+		}
+	}
+	return sum / float64(count)
+}
+
+func Part1(robots []Robot, p solver.Params) (int, error) {
+	return explainPart1(robots, p, nil)
+}
+
+func Part2(robots []Robot, p solver.Params) (int, error) {
+	return explainPart2(robots, p, nil)
+}
+
+func explainPart1(robots []Robot, p solver.Params, t *explain.Tracer) (int, error) {
+	field, err := fieldOf(p)
+	if err != nil {
+		return 0, err
+	}
+	simulatedRobots := simulateRobotIterations(slices.Clone(robots), field, p["seconds"])
+	return calcSecurityLevel(simulatedRobots, field, t), nil
+}
+
+// explainPart2 finds the second at which the robots are closest together,
+// tracing each second that beats the closest so far by the mean distance
+// between them.
+func explainPart2(robots []Robot, p solver.Params, t *explain.Tracer) (int, error) {
+	field, err := fieldOf(p)
+	if err != nil {
+		return 0, err
+	}
+	robots = slices.Clone(robots)
+	minDensity := math.Inf(1)
+	minTime := 0
+
+	// The robots are back where they started after Width*Height seconds,
+	// so every arrangement has been seen by then.
+	for second := 0; second < field.Width*field.Height; second++ {
+		density := robotDensity(robots)
+		if density < minDensity {
+			t.Event("closer", "second", second, "distance", density)
+			minDensity = density
+			minTime = second
+		}
+		robots = simulateRobotIterations(robots, field, 1) // Simulate one step at a time
+	}
+
+	return minTime, nil
+}
@@ -0,0 +1,146 @@
+package d14
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse/parsetest"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+func parseExample(t testing.TB, name string) []Robot {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	input, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", name, err)
+	}
+	return input
+}
+
+func TestParse(t *testing.T) {
+	robots := parseExample(t, "example.txt")
+	if len(robots) != 12 {
+		t.Fatalf("got %d robots, want 12", len(robots))
+	}
+	if want := (Robot{X: 0, Y: 4, VX: 3, VY: -3}); robots[0] != want {
+		t.Errorf("robots[0] = %+v, want %+v", robots[0], want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"p=1 v=2\n", `<input>:1:1: expected p=X,Y, found "p=1"`},
+		{"p=1,2\n", `<input>:1: expected p=X,Y v=X,Y, found "p=1,2"`},
+		{"p=1,2 q=3,4\n", `<input>:1:7: expected v=X,Y, found "q=3,4"`},
+		{"p=0,4 v=3,-3\np=a,1 v=1,1\n", `<input>:2:3: expected integer, found "a"`},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(strings.NewReader(tt.input))
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("Parse(%q) error = %v, want %s", tt.input, err, tt.want)
+		}
+	}
+}
+
+func TestParts(t *testing.T) {
+	input := parseExample(t, "example.txt")
+	params := Solver.Params(true)
+	if got, want := params.String(), "height=7 seconds=100 width=11"; got != want {
+		t.Fatalf("example params = %s, want %s", got, want)
+	}
+
+	got, err := Part1(input, params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 12 {
+		t.Errorf("got %v, want 12", got)
+	}
+
+	if _, err := Part1(input, Defaults.With(solver.Params{"width": 0})); err == nil {
+		t.Error("Part1 accepted an empty field")
+	}
+}
+
+func TestMoveRobot(t *testing.T) {
+	tests := []struct {
+		robot Robot
+		want  Robot
+	}{
+		{Robot{X: 2, Y: 4, VX: 2, VY: -3}, Robot{X: 4, Y: 1, VX: 2, VY: -3}},
+		{Robot{X: 0, Y: 0, VX: -1, VY: -1}, Robot{X: 100, Y: 102, VX: -1, VY: -1}},
+		{Robot{X: 100, Y: 102, VX: 1, VY: 1}, Robot{X: 0, Y: 0, VX: 1, VY: 1}},
+		{Robot{X: 1, Y: 1, VX: -205, VY: 310}, Robot{X: 99, Y: 2, VX: -205, VY: 310}},
+	}
+
+	field := Field{Width: 101, Height: 103}
+	for _, tt := range tests {
+		if got := moveRobot(tt.robot, field); got != tt.want {
+			t.Errorf("moveRobot(%+v) = %+v, want %+v", tt.robot, got, tt.want)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := explain.New(explain.Text(&buf), 14, 1)
+	if _, err := explainPart1(parseExample(t, "example.txt"), Solver.Params(true), tracer); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `day 14 part 1: quadrant index=0 robots=1
+day 14 part 1: quadrant index=1 robots=3
+day 14 part 1: quadrant index=2 robots=4
+day 14 part 1: quadrant index=3 robots=1
+`
+	if buf.String() != want {
+		t.Errorf("got trace\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	parsetest.Fuzz(f, Parse)
+}
+
+func BenchmarkParse(b *testing.B) {
+	data, err := os.ReadFile(filepath.Join("testdata", "example.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for range b.N {
+		if _, err := Parse(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part1(input, Solver.Params(true))
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part2(input, Solver.Params(true))
+	}
+}
@@ -0,0 +1,268 @@
+package d15
+
+import (
+	_ "embed"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/grid"
+	"github.com/reckerp/aoc-2024/parse"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+type Position = grid.Point
+
+var (
+	directions = map[rune]Position{
+		'^': grid.Up,
+		'>': grid.Right,
+		'v': grid.Down,
+		'<': grid.Left,
+	}
+)
+
+//go:embed testdata/example.txt
+var example []byte
+
+var Solver = solver.New(Parse, Part1, Part2, solver.WithExample(example), solver.WithVisuals(Visualize), solver.WithExplain(explainPart1, explainPart2))
+
+// Input holds the warehouse map and the robot's move instructions.
+type Input struct {
+	Grid         *grid.Grid[rune]
+	Instructions string
+}
+
+// Parse reads the warehouse map followed by the move instructions.
+func Parse(r io.Reader) (Input, error) {
+	warehouse, instructions, err := getInput(r)
+	return Input{Grid: warehouse, Instructions: instructions}, err
+}
+
+func Part1(in Input) (int, error) {
+	return explainPart1(in, nil, nil)
+}
+
+func Part2(in Input) (int, error) {
+	return explainPart2(in, nil, nil)
+}
+
+func explainPart1(in Input, _ solver.Params, t *explain.Tracer) (int, error) {
+	return solvePart1(in.Grid.Clone(), in.Instructions, t)
+}
+
+func explainPart2(in Input, _ solver.Params, t *explain.Tracer) (int, error) {
+	return solvePart2(in.Grid, in.Instructions, t)
+}
+
+// Visualize draws both warehouses after the robot has made every move.
+func Visualize(in Input, _ solver.Params) ([]solver.Visual, error) {
+	var visuals []solver.Visual
+	for _, w := range []struct {
+		title     string
+		warehouse *grid.Grid[rune]
+		part1     bool
+	}{
+		{"Warehouse after all moves", in.Grid.Clone(), true},
+		{"Wide warehouse after all moves", expandGrid(in.Grid), false},
+	} {
+		robotPos, err := findRobot(w.warehouse)
+		if err != nil {
+			return nil, err
+		}
+		robotPos = moveRobot(w.warehouse, robotPos, in.Instructions, w.part1, nil)
+		w.warehouse.Set(robotPos, '@')
+		visuals = append(visuals, solver.Visual{Title: w.title, Text: w.warehouse.Render(grid.Cell)})
+	}
+	return visuals, nil
+}
+
+func getInput(r io.Reader) (*grid.Grid[rune], string, error) {
+	scanner := parse.NewScanner(r)
+	warehouse, err := grid.Scan(scanner, "#.O@", grid.Rune)
+	if err != nil {
+		return nil, "", err
+	}
+
+	robots := 0
+	for p, ch := range warehouse.All() {
+		border := p.Y == 0 || p.Y == warehouse.Height()-1 || p.X == 0 || p.X == warehouse.Width()-1
+		if border && ch != '#' {
+			return nil, "", &parse.Error{Line: p.Y + 1, Col: p.X + 1, Expected: "wall '#' on the border", Found: string(ch)}
+		}
+		if ch == '@' {
+			robots++
+			if robots > 1 {
+				return nil, "", &parse.Error{Line: p.Y + 1, Col: p.X + 1, Expected: "a single robot", Found: string(ch)}
+			}
+		}
+	}
+	if robots == 0 {
+		return nil, "", parse.EOFErrorf("a robot '@' in the warehouse")
+	}
+
+	moves, err := scanner.Section()
+	if err != nil {
+		return nil, "", err
+	}
+	var instructions strings.Builder
+	for _, line := range moves {
+		for i, ch := range line.Text {
+			if _, ok := directions[ch]; !ok {
+				return nil, "", scanner.Errorf(parse.Field{Text: string(ch), Line: line.Line, Col: line.Col + i}, "move ^, >, v or <")
+			}
+		}
+		instructions.WriteString(line.Text)
+	}
+
+	return warehouse, instructions.String(), scanner.End()
+}
+
+func findRobot(warehouse *grid.Grid[rune]) (Position, error) {
+	pos, ok := warehouse.Find(grid.Equal('@'))
+	if !ok {
+		return Position{}, errors.New("robot not found in the grid")
+	}
+	warehouse.Set(pos, '.')
+	return pos, nil
+}
+
+func solvePart1(warehouse *grid.Grid[rune], instructions string, t *explain.Tracer) (int, error) {
+	robotPos, err := findRobot(warehouse)
+	if err != nil {
+		return 0, err
+	}
+	moveRobot(warehouse, robotPos, instructions, true, t)
+	return calculateGPSSum(warehouse, 'O'), nil
+}
+
+func solvePart2(warehouse *grid.Grid[rune], instructions string, t *explain.Tracer) (int, error) {
+	expandedGrid := expandGrid(warehouse)
+	robotPos, err := findRobot(expandedGrid)
+	if err != nil {
+		return 0, err
+	}
+	moveRobot(expandedGrid, robotPos, instructions, false, t)
+	return calculateGPSSum(expandedGrid, '['), nil
+}
+
+// moveRobot follows the instructions and returns where the robot ends up.
+// It traces every move that runs into boxes by its place in the
+// instructions, with where the robot stood and how many boxes it pushed.
+func moveRobot(warehouse *grid.Grid[rune], startPos Position, instructions string, part1 bool, t *explain.Tracer) Position {
+	currentPos := startPos
+	for i, instruction := range instructions {
+		dir := directions[instruction]
+		nextPos := currentPos.Add(dir)
+
+		switch next := warehouse.At(nextPos); {
+		case next == '#':
+			continue
+		case next == '.':
+			currentPos = nextPos
+		case (part1 && next == 'O') || (!part1 && (next == '[' || next == ']')):
+			if boxes, ok := pushBoxes(warehouse, currentPos, dir, part1); ok {
+				t.Event("pushed", "move", i+1, "direction", string(instruction), "x", currentPos.X, "y", currentPos.Y, "boxes", boxes)
+				currentPos = nextPos
+			} else {
+				t.Event("blocked", "move", i+1, "direction", string(instruction), "x", currentPos.X, "y", currentPos.Y)
+			}
+		}
+	}
+	return currentPos
+}
+
+// pushBoxes pushes the boxes in front of the robot one step in dir, unless
+// a wall blocks any of them. It returns how many boxes moved.
+func pushBoxes(warehouse *grid.Grid[rune], robotPos Position, dir Position, part1 bool) (int, bool) {
+	queue := []Position{robotPos}
+	seen := make(map[Position]bool)
+
+	for len(queue) > 0 {
+		pos := queue[0]
+		queue = queue[1:]
+
+		if seen[pos] {
+			continue
+		}
+		seen[pos] = true
+
+		nextPos := pos.Add(dir)
+		if warehouse.At(nextPos) == '#' {
+			return 0, false // Stop if blocked by an obstacle
+		}
+
+		if part1 {
+			// For part1, handle boxes ('O') and add them to the queue
+			if warehouse.At(nextPos) == 'O' {
+				queue = append(queue, nextPos)
+			}
+		} else {
+			// For part2, handle special cases '[' and ']' only
+			switch warehouse.At(nextPos) {
+			case '[':
+				queue = append(queue, nextPos, nextPos.Add(grid.Right))
+			case ']':
+				queue = append(queue, nextPos, nextPos.Add(grid.Left))
+			}
+		}
+	}
+
+	// Every box but the robot's own cell was seen, wide ones by both halves
+	boxes := len(seen) - 1
+	if !part1 {
+		boxes /= 2
+	}
+
+	// Move the boxes after the traversal is done
+	moveBoxes(warehouse, seen, dir)
+
+	return boxes, true
+}
+
+func moveBoxes(warehouse *grid.Grid[rune], boxPositions map[Position]bool, dir Position) {
+	for len(boxPositions) > 0 {
+		var toMove Position
+		for pos := range boxPositions {
+			nextPos := pos.Add(dir)
+			if !boxPositions[nextPos] {
+				// Move the box to the new position and clear the old position
+				warehouse.Set(nextPos, warehouse.At(pos))
+				warehouse.Set(pos, '.')
+				toMove = pos
+				break
+			}
+		}
+		delete(boxPositions, toMove)
+	}
+}
+
+// calculateGPSSum sums 100*row + col for every box. Wide boxes are measured
+// from their left edge, so part 2 passes the '[' half.
+func calculateGPSSum(warehouse *grid.Grid[rune], boxRune rune) int {
+	sum := 0
+	for _, p := range warehouse.FindAll(grid.Equal(boxRune)) {
+		sum += 100*p.Y + p.X
+	}
+	return sum
+}
+
+// expandGrid returns a new warehouse twice as wide, leaving the original
+// untouched.
+func expandGrid(warehouse *grid.Grid[rune]) *grid.Grid[rune] {
+	expanded := map[rune][2]rune{
+		'#': {'#', '#'},
+		'O': {'[', ']'},
+		'.': {'.', '.'},
+		'@': {'@', '.'},
+	}
+
+	expandedGrid := grid.New[rune](warehouse.Width()*2, warehouse.Height())
+	for p, ch := range warehouse.All() {
+		cells := expanded[ch]
+		expandedGrid.Set(Position{X: p.X * 2, Y: p.Y}, cells[0])
+		expandedGrid.Set(Position{X: p.X*2 + 1, Y: p.Y}, cells[1])
+	}
+	return expandedGrid
+}
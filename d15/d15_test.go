@@ -0,0 +1,131 @@
+package d15
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse/parsetest"
+)
+
+func parseExample(t testing.TB, name string) Input {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	input, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", name, err)
+	}
+	return input
+}
+
+func TestParts(t *testing.T) {
+	tests := []struct {
+		file string
+		part int
+		want int
+	}{
+		{"example_small.txt", 1, 2028},
+		{"example.txt", 1, 10092},
+		{"example.txt", 2, 9021},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/part%d", tt.file, tt.part), func(t *testing.T) {
+			input := parseExample(t, tt.file)
+			solve := Part1
+			if tt.part == 2 {
+				solve = Part2
+			}
+			got, err := solve(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"####\n#..#\n####\n\n<\n", "<input>: expected a robot '@' in the warehouse, found end of input"},
+		{"####\n#@@#\n####\n", `<input>:2:3: expected a single robot, found "@"`},
+		{"####\n#@..\n####\n", `<input>:2:4: expected wall '#' on the border, found "."`},
+		{"####\n#@.#\n####\n\n<>x\n", `<input>:5:3: expected move ^, >, v or <, found "x"`},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(strings.NewReader(tt.input))
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("Parse(%q) error = %v, want %s", tt.input, err, tt.want)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := explain.New(explain.Text(&buf), 15, 1)
+	if _, err := explainPart1(parseExample(t, "example_small.txt"), nil, tracer); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `day 15 part 1: pushed move=4 direction=> x=2 y=1 boxes=1
+day 15 part 1: pushed move=5 direction=> x=3 y=1 boxes=2
+day 15 part 1: blocked move=6 direction=> x=4 y=1
+day 15 part 1: pushed move=7 direction=v x=4 y=1 boxes=4
+day 15 part 1: blocked move=8 direction=v x=4 y=2
+day 15 part 1: pushed move=11 direction=> x=3 y=3 boxes=1
+day 15 part 1: pushed move=12 direction=> x=4 y=3 boxes=1
+day 15 part 1: pushed move=14 direction=< x=5 y=4 boxes=1
+day 15 part 1: blocked move=15 direction=< x=4 y=4
+`
+	if buf.String() != want {
+		t.Errorf("got trace\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	parsetest.Fuzz(f, Parse)
+}
+
+func BenchmarkParse(b *testing.B) {
+	data, err := os.ReadFile(filepath.Join("testdata", "example.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for range b.N {
+		if _, err := Parse(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part1(input)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part2(input)
+	}
+}
@@ -1,3 +0,0 @@
-module github.com/reckerp/aoc-2024/d15
-
-go 1.23.4
@@ -0,0 +1,191 @@
+package d16
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/grid"
+	"github.com/reckerp/aoc-2024/parse"
+	"github.com/reckerp/aoc-2024/search"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+type Point = grid.Point
+
+const (
+	StartMarker = 'S'
+	EndMarker   = 'E'
+	WallMarker  = '#'
+)
+
+// Directions: RIGHT, DOWN, LEFT, UP
+var Directions = []Point{grid.Right, grid.Down, grid.Left, grid.Up}
+
+// State is the reindeer's position and the index of its heading in
+// Directions.
+type State struct {
+	pos Point
+	dir int
+}
+
+//go:embed testdata/example.txt
+var example []byte
+
+// Defaults are the scores of turning 90 degrees and of moving one tile.
+var Defaults = solver.Params{"turn_cost": 1000, "move_cost": 1}
+
+var Solver = solver.NewWithParams(Parse, Part1, Part2, Defaults, solver.WithExample(example), solver.WithVisuals(Visualize), solver.WithExplain(explainPart1, explainPart2))
+
+// Input holds the maze and the reindeer's start and end tiles.
+type Input struct {
+	Grid       *grid.Grid[rune]
+	Start, End Point
+}
+
+// Parse reads the maze and locates its start and end markers.
+func Parse(r io.Reader) (Input, error) {
+	maze, start, end, err := getInput(r)
+	return Input{Grid: maze, Start: start, End: end}, err
+}
+
+func Part1(in Input, p solver.Params) (int, error) {
+	return explainPart1(in, p, nil)
+}
+
+func Part2(in Input, p solver.Params) (int, error) {
+	return explainPart2(in, p, nil)
+}
+
+// explainPart1 returns the lowest score, tracing the turns along one of
+// the best paths with the score on reaching them.
+func explainPart1(in Input, p solver.Params, t *explain.Tracer) (int, error) {
+	result, err := solve(in.Grid, in.Start, in.End, p)
+	if err != nil {
+		return 0, err
+	}
+	if t.Enabled() {
+		path := result.Path(result.Goals[0])
+		for i := 1; i < len(path); i++ {
+			if s := path[i]; s.dir != path[i-1].dir {
+				t.Event("turned", "x", s.pos.X, "y", s.pos.Y, "facing", string(">v<^"[s.dir]), "score", result.Dist[s])
+			}
+		}
+	}
+	cost, _ := result.Cost()
+	return cost, nil
+}
+
+// explainPart2 counts the tiles on the best paths, tracing them in reading
+// order.
+func explainPart2(in Input, p solver.Params, t *explain.Tracer) (int, error) {
+	result, err := solve(in.Grid, in.Start, in.End, p)
+	if err != nil {
+		return 0, err
+	}
+	if t.Enabled() {
+		tiles := bestTiles(result)
+		for tile := range in.Grid.All() {
+			if tiles[tile] {
+				t.Event("best tile", "x", tile.X, "y", tile.Y)
+			}
+		}
+	}
+	return countVisitedTiles(result), nil
+}
+
+// Visualize draws the maze with the tiles of every best path marked O.
+func Visualize(in Input, p solver.Params) ([]solver.Visual, error) {
+	result, err := solve(in.Grid, in.Start, in.End, p)
+	if err != nil {
+		return nil, err
+	}
+	tiles := bestTiles(result)
+	text := in.Grid.Render(func(p Point, cell rune) rune {
+		if tiles[p] && cell == '.' {
+			return 'O'
+		}
+		return cell
+	})
+	return []solver.Visual{{Title: "Best paths", Text: text}}, nil
+}
+
+func getInput(r io.Reader) (*grid.Grid[rune], Point, Point, error) {
+	maze, err := grid.Parse(r, "#.SE", grid.Rune)
+	if err != nil {
+		return nil, Point{}, Point{}, err
+	}
+
+	var markers [2]Point
+	for i, marker := range []rune{StartMarker, EndMarker} {
+		found := maze.FindAll(grid.Equal(marker))
+		switch {
+		case len(found) == 0:
+			return nil, Point{}, Point{}, parse.EOFErrorf("a %c tile", marker)
+		case len(found) > 1:
+			return nil, Point{}, Point{}, &parse.Error{Line: found[1].Y + 1, Col: found[1].X + 1, Expected: "a single " + string(marker) + " tile", Found: string(marker)}
+		}
+		markers[i] = found[0]
+	}
+	return maze, markers[0], markers[1], nil
+}
+
+// solve runs Dijkstra from the start tile facing east until every optimal
+// way of reaching the end tile is known, scoring moves with the turn_cost
+// and move_cost params. Both must be positive: free steps would leave
+// some of the optimal ways out.
+func solve(maze *grid.Grid[rune], start, end Point, p solver.Params) (*search.Result[State], error) {
+	turnCost, moveCost := p["turn_cost"], p["move_cost"]
+	if turnCost <= 0 || moveCost <= 0 {
+		return nil, fmt.Errorf("costs must be positive: turn_cost=%d, move_cost=%d", turnCost, moveCost)
+	}
+
+	result := search.Dijkstra(search.Problem[State]{
+		Start: []State{{pos: start, dir: 0}},
+		Neighbours: func(s State) iter.Seq[State] {
+			return func(yield func(State) bool) {
+				// Turn 90 degrees either way
+				for _, turn := range []int{1, len(Directions) - 1} {
+					if !yield(State{pos: s.pos, dir: (s.dir + turn) % len(Directions)}) {
+						return
+					}
+				}
+
+				// Move forward in the current direction
+				next := s.pos.Add(Directions[s.dir])
+				if cell, ok := maze.Get(next); ok && cell != WallMarker {
+					yield(State{pos: next, dir: s.dir})
+				}
+			}
+		},
+		Cost: func(from, to State) int {
+			if from.dir != to.dir {
+				return turnCost
+			}
+			return moveCost
+		},
+		Goal: func(s State) bool { return s.pos == end },
+	})
+
+	if !result.Found() {
+		return nil, errors.New("no path from the start tile to the end tile")
+	}
+	return result, nil
+}
+
+// countVisitedTiles counts the tiles that lie on at least one optimal path.
+func countVisitedTiles(result *search.Result[State]) int {
+	return len(bestTiles(result))
+}
+
+// bestTiles returns the tiles that lie on at least one optimal path.
+func bestTiles(result *search.Result[State]) map[Point]bool {
+	tiles := make(map[Point]bool)
+	for s := range result.OnPaths(result.Goals...) {
+		tiles[s.pos] = true
+	}
+	return tiles
+}
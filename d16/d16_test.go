@@ -0,0 +1,148 @@
+package d16
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse/parsetest"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+func parseExample(t testing.TB, name string) Input {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	input, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", name, err)
+	}
+	return input
+}
+
+func TestParts(t *testing.T) {
+	tests := []struct {
+		file string
+		part int
+		want int
+	}{
+		{"example.txt", 1, 7036},
+		{"example.txt", 2, 45},
+		{"example2.txt", 1, 11048},
+		{"example2.txt", 2, 64},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/part%d", tt.file, tt.part), func(t *testing.T) {
+			input := parseExample(t, tt.file)
+			solve := Part1
+			if tt.part == 2 {
+				solve = Part2
+			}
+			got, err := solve(input, Defaults)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRejectsFreeSteps(t *testing.T) {
+	input := parseExample(t, "example.txt")
+	for _, param := range []string{"turn_cost", "move_cost"} {
+		p := Defaults.With(solver.Params{param: 0})
+		if _, err := Part1(input, p); err == nil || !strings.Contains(err.Error(), "costs must be positive") {
+			t.Errorf("%s=0: error = %v, want one", param, err)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := explain.New(explain.Text(&buf), 16, 1)
+	if _, err := explainPart1(parseExample(t, "example.txt"), Defaults, tracer); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `day 16 part 1: turned x=1 y=13 facing=^ score=1000
+day 16 part 1: turned x=1 y=11 facing=> score=2002
+day 16 part 1: turned x=5 y=11 facing=^ score=3006
+day 16 part 1: turned x=5 y=7 facing=> score=4010
+day 16 part 1: turned x=11 y=7 facing=v score=5016
+day 16 part 1: turned x=11 y=13 facing=> score=6022
+day 16 part 1: turned x=13 y=13 facing=^ score=7024
+`
+	if buf.String() != want {
+		t.Errorf("got trace\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	parsetest.Fuzz(f, Parse)
+}
+
+func BenchmarkParse(b *testing.B) {
+	data, err := os.ReadFile(filepath.Join("testdata", "example.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for range b.N {
+		if _, err := Parse(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part1(input, Defaults)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part2(input, Defaults)
+	}
+}
+
+func TestVisualize(t *testing.T) {
+	tests := []struct {
+		file  string
+		tiles int
+	}{
+		{"example.txt", 45},
+		{"example2.txt", 64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			visuals, err := Visualize(parseExample(t, tt.file), Defaults)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(visuals) != 1 {
+				t.Fatalf("got %d visuals, want 1", len(visuals))
+			}
+			// Every best-path tile is drawn as O except the start and end.
+			if got := strings.Count(visuals[0].Text, "O") + 2; got != tt.tiles {
+				t.Errorf("marked %d tiles, want %d", got, tt.tiles)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+package d17
+
+import (
+	_ "embed"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/reckerp/aoc-2024/checked"
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+type Register struct {
+	name string
+	data int64
+}
+
+type Program struct {
+	ptr int
+	ops []int64
+}
+
+var (
+	//go:embed testdata/example1.txt
+	example1 []byte
+	//go:embed testdata/example2.txt
+	example2 []byte
+)
+
+var Solver = solver.New(Parse, Part1, Part2, solver.WithExamples(example1, example2), solver.WithReference(referencePart1, referencePart2), solver.WithExplain(explainPart1, explainPart2))
+
+// Input holds the program and the initial register values.
+type Input struct {
+	Program   Program
+	Registers []Register
+}
+
+// Parse reads the initial registers and the program.
+func Parse(r io.Reader) (Input, error) {
+	a, b, c, prg, err := getInput(r)
+	if err != nil {
+		return Input{}, err
+	}
+
+	prog := Program{
+		ptr: 0,
+		ops: prg,
+	}
+
+	regs := []Register{
+		{name: "A", data: a},
+		{name: "B", data: b},
+		{name: "C", data: c},
+	}
+
+	return Input{Program: prog, Registers: regs}, nil
+}
+
+func Part1(in Input) (string, error) {
+	return explainPart1(in, nil, nil)
+}
+
+func Part2(in Input) (int64, error) {
+	return explainPart2(in, nil, nil)
+}
+
+func explainPart1(in Input, _ solver.Params, t *explain.Tracer) (string, error) {
+	return part1(in.Program, in.Registers, t)
+}
+
+func explainPart2(in Input, _ solver.Params, t *explain.Tracer) (int64, error) {
+	return part2(in.Program, slices.Clone(in.Registers), t)
+}
+
+func getInput(r io.Reader) (int64, int64, int64, []int64, error) {
+	var registers [3]int64
+
+	scanner := parse.NewScanner(r)
+	values, err := scanner.Record("Register A", "Register B", "Register C")
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+	if values == nil {
+		return 0, 0, 0, nil, parse.EOFErrorf("Register A: ...")
+	}
+	for i, value := range values {
+		n, err := scanner.Int(value)
+		if err != nil {
+			return 0, 0, 0, nil, err
+		}
+		if n < 0 {
+			return 0, 0, 0, nil, scanner.Errorf(value, "non-negative integer")
+		}
+		registers[i] = int64(n)
+	}
+
+	values, err = scanner.Record("Program")
+	if err != nil {
+		return 0, 0, 0, nil, err
+	}
+	if values == nil {
+		return 0, 0, 0, nil, parse.EOFErrorf("Program: ...")
+	}
+	ops := values[0].Split(",")
+	program := make([]int64, len(ops))
+	for j, op := range ops {
+		n, err := scanner.Int(op)
+		if err != nil {
+			return 0, 0, 0, nil, err
+		}
+		if n < 0 || n > 7 {
+			return 0, 0, 0, nil, scanner.Errorf(op, "3-bit number")
+		}
+		program[j] = int64(n)
+	}
+	if len(program)%2 != 0 {
+		return 0, 0, 0, nil, scanner.Errorf(values[0], "opcode and operand pairs")
+	}
+	for j := 0; j < len(program); j += 2 {
+		if usesCombo(program[j]) && program[j+1] == 7 {
+			return 0, 0, 0, nil, scanner.Errorf(ops[j+1], "combo operand 0 to 6")
+		}
+	}
+
+	return registers[0], registers[1], registers[2], program, scanner.End()
+}
+
+func part1(prog Program, regs []Register, t *explain.Tracer) (string, error) {
+	vals, err := runProgram(prog, regs, t)
+	if err != nil {
+		return "", err
+	}
+	s := ""
+	for i := 0; i < len(vals); i++ {
+		s += fmt.Sprintf("%d", vals[i])
+		if i != len(vals)-1 {
+			s += ","
+		}
+	}
+	return s, nil
+}
+
+func part2(prog Program, regs []Register, t *explain.Tracer) (int64, error) {
+	return findQuine(prog, regs, t)
+}
+
+type State struct {
+	segs []int64
+}
+
+// findQuine returns the lowest value of register A that makes the program
+// print itself, or 0 if there is none. It fails if that value does not fit
+// in 63 bits, which happens for programs of more than 21 numbers. It traces
+// each value of A whose output matches the end of the program.
+func findQuine(prog Program, regs []Register, t *explain.Tracer) (int64, error) {
+	queue := []State{}
+	for i := 0; i < 8; i++ {
+		queue = append(queue, State{[]int64{int64(i)}})
+	}
+	var final int64
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		var x int64
+		for i := len(cur.segs) - 1; i >= 0; i-- {
+			s, ok := checked.Shl(int(cur.segs[i]), uint(3*i))
+			if !ok {
+				return 0, fmt.Errorf("%w: register A needs more than 63 bits", checked.ErrOverflow)
+			}
+			x = x | int64(s)
+		}
+
+		regs[0].data = x
+		vals, err := runProgram(prog, regs, nil)
+		if err != nil {
+			return 0, err
+		}
+		vp := 0
+		// Each 3-bit segment must add one output. A candidate whose top
+		// segment is zero has fewer outputs than segments, and prepending
+		// segments to it would only revisit smaller values forever.
+		matched := len(vals) == len(cur.segs) && len(vals) <= len(prog.ops)
+		for p := len(prog.ops) - len(vals); matched && p < len(prog.ops); p++ {
+			if vals[vp] != prog.ops[p] {
+				matched = false
+				break
+			}
+			vp++
+		}
+
+		if matched {
+			t.Event("matched", "a", x, "outputs", len(vals))
+		}
+
+		done := matched && len(prog.ops) == len(vals)
+		if done {
+			final = x
+			break
+		}
+
+		if matched {
+			for i := 0; i < 8; i++ {
+				nseg := make([]int64, len(cur.segs))
+				copy(nseg, cur.segs)
+				nseg = append([]int64{int64(i)}, nseg...)
+				queue = append(queue, State{nseg})
+			}
+		}
+	}
+	return final, nil
+}
+
+// usesCombo reports whether the operand of an instruction is a combo
+// operand rather than a literal.
+func usesCombo(opcode int64) bool {
+	return opcode != 1 && opcode != 3 && opcode != 4
+}
+
+// runProgram runs the program and returns its output, tracing each number
+// it outputs by the position of the instruction and register A.
+func runProgram(prog Program, regs []Register, t *explain.Tracer) ([]int64, error) {
+	output := []int64{}
+	rm := make(map[string]Register)
+	for _, r := range regs {
+		rm[r.name] = r
+	}
+
+	// getVal returns the value of a combo operand. Operand 7 is reserved,
+	// and only reachable when a jump lands on an odd index.
+	getVal := func(i int64) (int64, error) {
+		switch i {
+		case 4:
+			return rm["A"].data, nil
+		case 5:
+			return rm["B"].data, nil
+		case 6:
+			return rm["C"].data, nil
+		case 7:
+			return 0, fmt.Errorf("reserved combo operand 7 at %d", prog.ptr+1)
+		}
+		return i, nil
+	}
+
+	setVal := func(r string, i int64) {
+		reg := rm[r]
+		reg.data = i
+		rm[r] = reg
+	}
+
+	// The machine halts when the pointer leaves the program or points at
+	// its last number, which has no operand.
+	for prog.ptr+1 < len(prog.ops) {
+		cur := prog.ops[prog.ptr]
+		operand := prog.ops[prog.ptr+1]
+
+		combo := operand
+		if usesCombo(cur) {
+			var err error
+			if combo, err = getVal(operand); err != nil {
+				return nil, err
+			}
+		}
+
+		dojump := true
+		jmp := 2
+		switch cur {
+		case 0:
+			setVal("A", rm["A"].data>>combo)
+		case 1:
+			n := rm["B"].data
+			v := n ^ operand
+			setVal("B", v)
+		case 2:
+			setVal("B", combo&7)
+		case 3:
+			jnz := rm["A"].data
+			if jnz != 0 {
+				dojump = false
+				prog.ptr = int(operand)
+			}
+		case 4:
+			b := rm["B"].data
+			c := rm["C"].data
+			v := b ^ c
+			setVal("B", v)
+		case 5:
+			t.Event("output", "at", prog.ptr, "value", combo&7, "a", rm["A"].data)
+			output = append(output, combo&7)
+		case 6:
+			setVal("B", rm["A"].data>>combo)
+		case 7:
+			setVal("C", rm["A"].data>>combo)
+		}
+		if dojump {
+			prog.ptr += jmp
+		}
+
+	}
+	return output, nil
+}
@@ -0,0 +1,213 @@
+package d17
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/checked"
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse/parsetest"
+)
+
+func parseExample(t testing.TB, name string) Input {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	input, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", name, err)
+	}
+	return input
+}
+
+func TestPart1(t *testing.T) {
+	got, err := Part1(parseExample(t, "example1.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "4,6,3,5,6,3,5,2,1,0"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestPart2(t *testing.T) {
+	got, err := Part2(parseExample(t, "example2.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 117440 {
+		t.Errorf("got %d, want 117440", got)
+	}
+}
+
+func TestReference(t *testing.T) {
+	got1, err := referencePart1(parseExample(t, "example1.txt"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got1 != "4,6,3,5,6,3,5,2,1,0" {
+		t.Errorf("part 1: got %s, want 4,6,3,5,6,3,5,2,1,0", got1)
+	}
+	got2, err := referencePart2(parseExample(t, "example2.txt"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got2 != 117440 {
+		t.Errorf("part 2: got %d, want 117440", got2)
+	}
+}
+
+func TestFindQuineWithoutQuine(t *testing.T) {
+	// The program prints the octal digits of A, so a quine would need a
+	// leading zero digit. The search used to keep prepending segments to
+	// zero and never return.
+	prog := Program{ops: []int64{2, 4, 1, 0, 5, 5, 0, 3, 3, 0}}
+	regs := []Register{{name: "A"}, {name: "B"}, {name: "C"}}
+	if got, err := findQuine(prog, regs, nil); err != nil || got != 0 {
+		t.Errorf("findQuine() = %d, %v, want 0", got, err)
+	}
+}
+
+func TestFindQuineOverflow(t *testing.T) {
+	// The example quine padded with no-ops to 22 numbers, so register A
+	// needs 22 octal digits.
+	ops := slices.Concat(slices.Repeat([]int64{1, 0}, 8), []int64{0, 3, 5, 4, 3, 0})
+	regs := []Register{{name: "A"}, {name: "B"}, {name: "C"}}
+	if _, err := findQuine(Program{ops: ops}, regs, nil); !errors.Is(err, checked.ErrOverflow) {
+		t.Errorf("findQuine() error = %v, want an overflow error", err)
+	}
+}
+
+func TestRunProgramMatchesReference(t *testing.T) {
+	tests := []struct {
+		name    string
+		ops     []int64
+		a, b, c int64
+		wantErr bool
+	}{
+		{"shift past 63 bits", []int64{2, 6, 0, 5, 5, 4}, 1 << 62, 100, 1 << 40, false},
+		{"jump to the last number", []int64{5, 4, 3, 3}, 5, 0, 0, false},
+		{"jump to an odd index", []int64{3, 1, 5, 0}, 1, 0, 0, false},
+		{"reserved operand after an odd jump", []int64{3, 3, 0, 5, 7, 0}, 1, 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			regs := []Register{{name: "A", data: tt.a}, {name: "B", data: tt.b}, {name: "C", data: tt.c}}
+			got, err := runProgram(Program{ops: tt.ops}, regs, nil)
+			want, wantErr := referenceRun(tt.ops, tt.a, tt.b, tt.c)
+			if (err != nil) != tt.wantErr || (wantErr != nil) != tt.wantErr {
+				t.Fatalf("errors %v and %v, want an error: %v", err, wantErr, tt.wantErr)
+			}
+			if !slices.Equal(got, want) {
+				t.Errorf("runProgram() = %v, reference gives %v", got, want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Register A: 1\nRegister B: 0\n", "<input>: expected Register C: ..., found end of input"},
+		{"Register A: x\nRegister B: 0\nRegister C: 0\n", `<input>:1:13: expected integer, found "x"`},
+		{"Register A: 1\nRegister B: 0\nRegister C: 0\nProgram: 0,1\n", `<input>:4: expected blank line, found "Program: 0,1"`},
+		{"Register B: 1\n", `<input>:1: expected Register A: ..., found "Register B: 1"`},
+		{"Register A: -1\nRegister B: 0\nRegister C: 0\n", `<input>:1:13: expected non-negative integer, found "-1"`},
+		{"Register A: 1\nRegister B: 0\nRegister C: 0\n\nProgram: 1,7,5,7\n", `<input>:5:16: expected combo operand 0 to 6, found "7"`},
+		{"Register A: 1\nRegister B: 0\nRegister C: 0\n\nProgram: 0,9\n", `<input>:5:12: expected 3-bit number, found "9"`},
+		{"Register A: 1\nRegister B: 0\nRegister C: 0\n\nProgram: 0,1,5\n", `<input>:5:10: expected opcode and operand pairs, found "0,1,5"`},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(strings.NewReader(tt.input))
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("Parse(%q) error = %v, want %s", tt.input, err, tt.want)
+		}
+	}
+}
+
+func TestRunProgram(t *testing.T) {
+	tests := []struct {
+		a    int64
+		ops  []int64
+		want []int64
+	}{
+		{10, []int64{5, 0, 5, 1, 5, 4}, []int64{0, 1, 2}},
+		{2024, []int64{0, 1, 5, 4, 3, 0}, []int64{4, 2, 5, 6, 7, 7, 7, 7, 3, 1, 0}},
+		{117440, []int64{0, 3, 5, 4, 3, 0}, []int64{0, 3, 5, 4, 3, 0}},
+	}
+
+	for _, tt := range tests {
+		regs := []Register{{name: "A", data: tt.a}, {name: "B"}, {name: "C"}}
+		if got, err := runProgram(Program{ops: tt.ops}, regs, nil); err != nil || !slices.Equal(got, tt.want) {
+			t.Errorf("runProgram(A=%d, %v) = %v, %v, want %v", tt.a, tt.ops, got, err, tt.want)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := explain.New(explain.Text(&buf), 17, 1)
+	if _, err := explainPart1(parseExample(t, "example1.txt"), nil, tracer); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `day 17 part 1: output at=2 value=4 a=364
+day 17 part 1: output at=2 value=6 a=182
+day 17 part 1: output at=2 value=3 a=91
+day 17 part 1: output at=2 value=5 a=45
+day 17 part 1: output at=2 value=6 a=22
+day 17 part 1: output at=2 value=3 a=11
+day 17 part 1: output at=2 value=5 a=5
+day 17 part 1: output at=2 value=2 a=2
+day 17 part 1: output at=2 value=1 a=1
+day 17 part 1: output at=2 value=0 a=0
+`
+	if buf.String() != want {
+		t.Errorf("got trace\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	parsetest.Fuzz(f, Parse)
+}
+
+func BenchmarkParse(b *testing.B) {
+	data, err := os.ReadFile(filepath.Join("testdata", "example1.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for range b.N {
+		if _, err := Parse(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	input := parseExample(b, "example1.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part1(input)
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	input := parseExample(b, "example2.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part2(input)
+	}
+}
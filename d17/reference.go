@@ -0,0 +1,91 @@
+package d17
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+// maxReferenceProgram is the length of the longest program the reference
+// solution of part 2 searches, which means trying 8^6 values of A.
+const maxReferenceProgram = 6
+
+// referenceRun runs a program on registers a, b and c exactly as the puzzle
+// describes the machine and returns its output.
+func referenceRun(ops []int64, a, b, c int64) ([]int64, error) {
+	var out []int64
+	for ip := 0; ip+1 < len(ops); {
+		opcode, operand := ops[ip], ops[ip+1]
+		combo := operand
+		switch operand {
+		case 4:
+			combo = a
+		case 5:
+			combo = b
+		case 6:
+			combo = c
+		case 7:
+			if opcode != 1 && opcode != 3 && opcode != 4 {
+				return nil, fmt.Errorf("reserved combo operand 7 at %d", ip+1)
+			}
+		}
+
+		ip += 2
+		switch opcode {
+		case 0: // adv
+			a >>= combo
+		case 1: // bxl
+			b ^= operand
+		case 2: // bst
+			b = combo & 7
+		case 3: // jnz
+			if a != 0 {
+				ip = int(operand)
+			}
+		case 4: // bxc
+			b ^= c
+		case 5: // out
+			out = append(out, combo&7)
+		case 6: // bdv
+			b = a >> combo
+		case 7: // cdv
+			c = a >> combo
+		}
+	}
+	return out, nil
+}
+
+func referencePart1(in Input, _ solver.Params) (string, error) {
+	out, err := referenceRun(in.Program.ops, in.Registers[0].data, in.Registers[1].data, in.Registers[2].data)
+	if err != nil {
+		return "", err
+	}
+	fields := make([]string, len(out))
+	for i, v := range out {
+		fields[i] = fmt.Sprint(v)
+	}
+	return strings.Join(fields, ","), nil
+}
+
+// referencePart2 tries every value of register A in turn. Programs shaped
+// like the puzzle's print one 3-bit digit of A per loop, so a program of n
+// numbers can only print itself for A below 8^n, which bounds the search.
+// It returns 0 if no value works.
+func referencePart2(in Input, _ solver.Params) (int64, error) {
+	ops := in.Program.ops
+	if len(ops) > maxReferenceProgram {
+		return 0, fmt.Errorf("%w for programs longer than %d numbers", solver.ErrNoReference, maxReferenceProgram)
+	}
+	for a := int64(0); a < int64(1)<<(3*len(ops)); a++ {
+		out, err := referenceRun(ops, a, in.Registers[1].data, in.Registers[2].data)
+		if err != nil {
+			return 0, err
+		}
+		if slices.Equal(out, ops) {
+			return a, nil
+		}
+	}
+	return 0, nil
+}
@@ -0,0 +1,267 @@
+package d18
+
+import (
+	_ "embed"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse"
+	"github.com/reckerp/aoc-2024/search"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+// Defaults are the largest coordinate of the memory space and how many
+// bytes have fallen in part 1. The example's are in
+// testdata/example.params.json.
+var Defaults = solver.Params{"size": 70, "bytes": 1024}
+
+var (
+	//go:embed testdata/example.txt
+	example []byte
+	//go:embed testdata/example.params.json
+	exampleParams []byte
+)
+
+var Solver = solver.NewWithParams(Parse, Part1, Part2, Defaults, solver.WithExample(example), solver.WithExampleParams(exampleParams), solver.WithVisuals(Visualize), solver.WithExplain(explainPart1, explainPart2))
+
+type Point struct {
+	x, y int
+}
+
+func (p Point) String() string {
+	return fmt.Sprintf("%d,%d", p.x, p.y)
+}
+
+var directions = []Point{{x: 1, y: 0}, {x: -1, y: 0}, {x: 0, y: 1}, {x: 0, y: -1}}
+
+// findShortestPath returns the number of steps from the top-left corner to
+// the bottom-right one, or -1 when the corrupted spaces block every path.
+func findShortestPath(corruptedSpaces map[Point]bool, gridSize int) int {
+	steps, ok := shortestPath(corruptedSpaces, gridSize).Cost()
+	if !ok {
+		return -1 // No path found
+	}
+	return steps
+}
+
+// shortestPath searches from the top-left corner to the bottom-right one.
+func shortestPath(corruptedSpaces map[Point]bool, gridSize int) *search.Result[Point] {
+	start := Point{x: 0, y: 0}
+	end := Point{x: gridSize, y: gridSize}
+
+	return search.AStar(search.Problem[Point]{
+		Start: []Point{start},
+		Neighbours: func(p Point) iter.Seq[Point] {
+			return func(yield func(Point) bool) {
+				for _, dir := range directions {
+					next := Point{x: p.x + dir.x, y: p.y + dir.y}
+					if next.x < 0 || next.x > gridSize || next.y < 0 || next.y > gridSize {
+						continue
+					}
+					if corruptedSpaces[next] {
+						continue
+					}
+					if !yield(next) {
+						return
+					}
+				}
+			}
+		},
+		Heuristic: func(p Point) int { return manhattanDistance(p, end) },
+		Goal:      func(p Point) bool { return p == end },
+	})
+}
+
+// findFirstBlockingByte lets the bytes fall one at a time until one blocks
+// every path, tracing the steps still needed after each one.
+func findFirstBlockingByte(coordinates []Point, gridSize int, t *explain.Tracer) Point {
+	corruptedSpaces := make(map[Point]bool)
+
+	for i, coord := range coordinates {
+		// Add current coordinate to corrupted spaces
+		corruptedSpaces[coord] = true
+
+		// Check if path is blocked
+		steps := findShortestPath(corruptedSpaces, gridSize)
+		if steps == -1 {
+			t.Event("blocked", "byte", i+1, "at", coord)
+			return coord
+		}
+		t.Event("fell", "byte", i+1, "at", coord, "steps", steps)
+	}
+
+	// This should not happen based on problem description
+	return Point{x: -1, y: -1}
+}
+
+// Parse reads the coordinates of every falling byte in order.
+func Parse(r io.Reader) ([]Point, error) {
+	return readAllCoordinates(r)
+}
+
+func readAllCoordinates(r io.Reader) ([]Point, error) {
+	var coordinates []Point
+	scanner := parse.NewScanner(r)
+
+	for scanner.Scan() {
+		if scanner.Text() == "" {
+			continue
+		}
+
+		parts := scanner.Field().Split(",")
+		if len(parts) != 2 {
+			return nil, scanner.LineErrorf("X,Y")
+		}
+
+		var xy [2]int
+		for i, part := range parts {
+			n, err := scanner.Int(part)
+			if err != nil {
+				return nil, err
+			}
+			if n < 0 {
+				return nil, scanner.Errorf(part, "non-negative coordinate")
+			}
+			xy[i] = n
+		}
+
+		coordinates = append(coordinates, Point{x: xy[0], y: xy[1]})
+	}
+
+	return coordinates, scanner.Err()
+}
+
+func manhattanDistance(a, b Point) int {
+	return abs(a.x-b.x) + abs(a.y-b.y)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Visualize draws the memory space once the first bytes have fallen, with
+// the shortest path marked O, and again once the first blocking byte, marked
+// X, has fallen.
+func Visualize(coordinates []Point, p solver.Params) ([]solver.Visual, error) {
+	gridSize, fallen, err := memory(coordinates, p)
+	if err != nil {
+		return nil, err
+	}
+	visuals := []solver.Visual{{
+		Title: fmt.Sprintf("Shortest path after %d bytes", len(fallen)),
+		Text:  drawMemory(fallen, gridSize),
+	}}
+
+	blocking := findFirstBlockingByte(coordinates, gridSize, nil)
+	if blocking.x != -1 {
+		fallen = coordinates[:slices.Index(coordinates, blocking)+1]
+		visuals = append(visuals, solver.Visual{
+			Title: fmt.Sprintf("First blocking byte at %s", blocking),
+			Text:  drawMemory(fallen, gridSize),
+		})
+	}
+	return visuals, nil
+}
+
+// drawMemory draws the fallen bytes as # and, if there still is one, the
+// shortest path through them as O. The last byte to fall is drawn as X
+// when it blocks every path.
+func drawMemory(fallen []Point, gridSize int) string {
+	corruptedSpaces := make(map[Point]bool)
+	for _, coord := range fallen {
+		corruptedSpaces[coord] = true
+	}
+
+	end := Point{x: gridSize, y: gridSize}
+	result := shortestPath(corruptedSpaces, gridSize)
+	path := make(map[Point]bool)
+	for _, p := range result.Path(end) {
+		path[p] = true
+	}
+
+	var b strings.Builder
+	for y := 0; y <= gridSize; y++ {
+		for x := 0; x <= gridSize; x++ {
+			p := Point{x: x, y: y}
+			switch {
+			case !result.Found() && p == fallen[len(fallen)-1]:
+				b.WriteByte('X')
+			case corruptedSpaces[p]:
+				b.WriteByte('#')
+			case path[p]:
+				b.WriteByte('O')
+			default:
+				b.WriteByte('.')
+			}
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// memory returns the size param and the bytes that have fallen in part 1,
+// checking that every byte lands inside the memory space.
+func memory(coordinates []Point, p solver.Params) (int, []Point, error) {
+	gridSize := p["size"]
+	if gridSize < 0 {
+		return 0, nil, fmt.Errorf("invalid memory size %d", gridSize)
+	}
+	for _, coord := range coordinates {
+		if coord.x > gridSize || coord.y > gridSize {
+			return 0, nil, fmt.Errorf("byte at %s falls outside the memory space of size %d", coord, gridSize)
+		}
+	}
+	return gridSize, coordinates[:min(max(p["bytes"], 0), len(coordinates))], nil
+}
+
+func Part1(coordinates []Point, p solver.Params) (int, error) {
+	return explainPart1(coordinates, p, nil)
+}
+
+// explainPart1 returns the steps needed once the first bytes have fallen,
+// tracing every step of one shortest path.
+func explainPart1(coordinates []Point, p solver.Params, t *explain.Tracer) (int, error) {
+	gridSize, fallen, err := memory(coordinates, p)
+	if err != nil {
+		return 0, err
+	}
+	corruptedSpaces := make(map[Point]bool)
+	for _, coord := range fallen {
+		corruptedSpaces[coord] = true
+	}
+	result := shortestPath(corruptedSpaces, gridSize)
+	steps, ok := result.Cost()
+	if !ok {
+		return -1, nil // No path found
+	}
+	if t.Enabled() {
+		path := result.Path(Point{x: gridSize, y: gridSize})
+		for i := 1; i < len(path); i++ {
+			t.Event("moved", "step", i, "to", path[i])
+		}
+	}
+	return steps, nil
+}
+
+func Part2(coordinates []Point, p solver.Params) (Point, error) {
+	return explainPart2(coordinates, p, nil)
+}
+
+func explainPart2(coordinates []Point, p solver.Params, t *explain.Tracer) (Point, error) {
+	gridSize, _, err := memory(coordinates, p)
+	if err != nil {
+		return Point{}, err
+	}
+	blockingPoint := findFirstBlockingByte(coordinates, gridSize, t)
+	if blockingPoint.x == -1 {
+		return Point{}, fmt.Errorf("no byte blocks the path to the exit")
+	}
+	return blockingPoint, nil
+}
@@ -0,0 +1,180 @@
+package d18
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse/parsetest"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+func parseExample(t testing.TB, name string) []Point {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	input, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", name, err)
+	}
+	return input
+}
+
+func TestParts(t *testing.T) {
+	input := parseExample(t, "example.txt")
+	params := Solver.Params(true)
+
+	steps, err := Part1(input, params)
+	if err != nil || steps != 22 {
+		t.Errorf("Part1 = %d, %v; want 22", steps, err)
+	}
+	blocking, err := Part2(input, params)
+	if err != nil || blocking.String() != "6,1" {
+		t.Errorf("Part2 = %v, %v; want 6,1", blocking, err)
+	}
+
+	if _, err := Part1(input, Defaults.With(solver.Params{"size": 5})); err == nil {
+		t.Error("Part1 accepted a byte outside the memory space")
+	}
+}
+
+func TestFindShortestPath(t *testing.T) {
+	coordinates := parseExample(t, "example.txt")
+
+	corruptedSpaces := make(map[Point]bool)
+	for _, coord := range coordinates[:12] {
+		corruptedSpaces[coord] = true
+	}
+
+	if got := findShortestPath(corruptedSpaces, 6); got != 22 {
+		t.Errorf("got %d steps, want 22", got)
+	}
+}
+
+func TestFindFirstBlockingByte(t *testing.T) {
+	coordinates := parseExample(t, "example.txt")
+
+	if got, want := findFirstBlockingByte(coordinates, 6, nil), (Point{6, 1}); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestExplainPart1(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := explain.New(explain.Text(&buf), 18, 1)
+	steps, err := explainPart1(parseExample(t, "example.txt"), Solver.Params(true), tracer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if steps != 22 {
+		t.Errorf("got %d steps, want 22", steps)
+	}
+
+	want := `day 18 part 1: moved step=1 to=1,0
+day 18 part 1: moved step=2 to=1,1
+day 18 part 1: moved step=3 to=1,2
+day 18 part 1: moved step=4 to=2,2
+day 18 part 1: moved step=5 to=3,2
+day 18 part 1: moved step=6 to=3,1
+day 18 part 1: moved step=7 to=4,1
+day 18 part 1: moved step=8 to=4,0
+day 18 part 1: moved step=9 to=5,0
+day 18 part 1: moved step=10 to=6,0
+day 18 part 1: moved step=11 to=6,1
+day 18 part 1: moved step=12 to=6,2
+day 18 part 1: moved step=13 to=5,2
+day 18 part 1: moved step=14 to=5,3
+day 18 part 1: moved step=15 to=4,3
+day 18 part 1: moved step=16 to=4,4
+day 18 part 1: moved step=17 to=3,4
+day 18 part 1: moved step=18 to=3,5
+day 18 part 1: moved step=19 to=3,6
+day 18 part 1: moved step=20 to=4,6
+day 18 part 1: moved step=21 to=5,6
+day 18 part 1: moved step=22 to=6,6
+`
+	if buf.String() != want {
+		t.Errorf("got trace\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestExplainPart2(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := explain.New(explain.Text(&buf), 18, 2)
+	if _, err := explainPart2(parseExample(t, "example.txt"), Solver.Params(true), tracer); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `day 18 part 2: fell byte=1 at=5,4 steps=12
+day 18 part 2: fell byte=2 at=4,2 steps=12
+day 18 part 2: fell byte=3 at=4,5 steps=12
+day 18 part 2: fell byte=4 at=3,0 steps=12
+day 18 part 2: fell byte=5 at=2,1 steps=12
+day 18 part 2: fell byte=6 at=6,3 steps=12
+day 18 part 2: fell byte=7 at=2,4 steps=12
+day 18 part 2: fell byte=8 at=1,5 steps=12
+day 18 part 2: fell byte=9 at=0,6 steps=12
+day 18 part 2: fell byte=10 at=3,3 steps=18
+day 18 part 2: fell byte=11 at=2,6 steps=18
+day 18 part 2: fell byte=12 at=5,1 steps=22
+day 18 part 2: fell byte=13 at=1,2 steps=24
+day 18 part 2: fell byte=14 at=5,5 steps=24
+day 18 part 2: fell byte=15 at=2,5 steps=24
+day 18 part 2: fell byte=16 at=6,5 steps=24
+day 18 part 2: fell byte=17 at=1,4 steps=24
+day 18 part 2: fell byte=18 at=0,4 steps=24
+day 18 part 2: fell byte=19 at=6,4 steps=24
+day 18 part 2: fell byte=20 at=1,1 steps=24
+day 18 part 2: blocked byte=21 at=6,1
+`
+	if buf.String() != want {
+		t.Errorf("got trace\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	parsetest.Fuzz(f, Parse)
+}
+
+func BenchmarkParse(b *testing.B) {
+	data, err := os.ReadFile(filepath.Join("testdata", "example.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for range b.N {
+		if _, err := Parse(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPart1(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part1(input, Solver.Params(true))
+	}
+}
+
+func BenchmarkPart2(b *testing.B) {
+	input := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		Part2(input, Solver.Params(true))
+	}
+}
+
+func BenchmarkFindFirstBlockingByte(b *testing.B) {
+	coordinates := parseExample(b, "example.txt")
+	b.ResetTimer()
+	for range b.N {
+		findFirstBlockingByte(coordinates, 6, nil)
+	}
+}
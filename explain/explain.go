@@ -0,0 +1,159 @@
+// Package explain records the decisions a day's parts make on their way to
+// the answer, such as the level a report dropped or the operators that
+// balanced an equation, so a wrong answer can be traced without adding
+// prints to the code.
+//
+// Parts report events to a Tracer, which hands them to a Sink. A nil Tracer
+// discards everything, so parts take one unconditionally and check Enabled
+// only before work that serves nothing but the trace.
+package explain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Event is one decision made while solving a part.
+type Event struct {
+	Day  int
+	Part int
+	// Name says what happened, e.g. "removed level".
+	Name string
+	// Fields hold the details, in the order the part gave them.
+	Fields []Field
+}
+
+// Field is a named detail of an event.
+type Field struct {
+	Key   string
+	Value any
+}
+
+// Sink receives the events of every part being explained. Sinks are safe
+// for concurrent use.
+type Sink interface {
+	Emit(Event) error
+}
+
+// Text returns a sink writing one line of text per event:
+//
+//	day 2 part 2: removed level line=4 index=2 level=4
+func Text(w io.Writer) Sink {
+	return &writerSink{w: w, format: formatText}
+}
+
+// JSONLines returns a sink writing one JSON object per line and event, with
+// the fields after the day, part and event name:
+//
+//	{"day":2,"part":2,"event":"removed level","line":4,"index":2,"level":4}
+func JSONLines(w io.Writer) Sink {
+	return &writerSink{w: w, format: formatJSON}
+}
+
+// Sinks maps the name of each sink format to its constructor.
+var Sinks = map[string]func(io.Writer) Sink{
+	"text": Text,
+	"json": JSONLines,
+}
+
+type writerSink struct {
+	mu     sync.Mutex
+	w      io.Writer
+	format func(*bytes.Buffer, Event) error
+}
+
+func (s *writerSink) Emit(e Event) error {
+	var buf bytes.Buffer
+	if err := s.format(&buf, e); err != nil {
+		return err
+	}
+	buf.WriteByte('\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err := s.w.Write(buf.Bytes())
+	return err
+}
+
+func formatText(buf *bytes.Buffer, e Event) error {
+	fmt.Fprintf(buf, "day %d part %d: %s", e.Day, e.Part, e.Name)
+	for _, f := range e.Fields {
+		value := fmt.Sprint(f.Value)
+		if s, ok := f.Value.(string); ok && (s == "" || strings.ContainsAny(s, " =\"")) {
+			value = strconv.Quote(s)
+		}
+		fmt.Fprintf(buf, " %s=%s", f.Key, value)
+	}
+	return nil
+}
+
+func formatJSON(buf *bytes.Buffer, e Event) error {
+	name, err := json.Marshal(e.Name)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(buf, `{"day":%d,"part":%d,"event":%s`, e.Day, e.Part, name)
+	for _, f := range e.Fields {
+		key, err := json.Marshal(f.Key)
+		if err != nil {
+			return err
+		}
+		value, err := json.Marshal(f.Value)
+		if err != nil {
+			return fmt.Errorf("event %q: field %q: %w", e.Name, f.Key, err)
+		}
+		fmt.Fprintf(buf, ",%s:%s", key, value)
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+// Tracer reports the events of one part to a sink. The nil Tracer is valid
+// and discards them.
+type Tracer struct {
+	sink      Sink
+	day, part int
+	err       error
+}
+
+// New returns a tracer reporting the events of a day's part to sink.
+func New(sink Sink, day, part int) *Tracer {
+	return &Tracer{sink: sink, day: day, part: part}
+}
+
+// Enabled reports whether events are recorded, for parts to skip work that
+// only serves to explain.
+func (t *Tracer) Enabled() bool {
+	return t != nil
+}
+
+// Event reports that something named name happened. The details follow as
+// alternating keys and values, like "line", 4, "level", 7.
+func (t *Tracer) Event(name string, keyvals ...any) {
+	if t == nil || t.err != nil {
+		return
+	}
+	e := Event{Day: t.day, Part: t.part, Name: name}
+	for i := 0; i < len(keyvals); i += 2 {
+		f := Field{Key: fmt.Sprint(keyvals[i])}
+		if i+1 < len(keyvals) {
+			f.Value = keyvals[i+1]
+		}
+		e.Fields = append(e.Fields, f)
+	}
+	t.err = t.sink.Emit(e)
+}
+
+// Err returns the first error the sink returned. Once it fails, later
+// events are dropped.
+func (t *Tracer) Err() error {
+	if t == nil {
+		return nil
+	}
+	return t.err
+}
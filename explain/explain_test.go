@@ -0,0 +1,80 @@
+package explain
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestText(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := New(Text(&buf), 7, 2)
+	tracer.Event("solved", "line", 1, "expression", "10 * 19", "ops", []string{"*"})
+	tracer.Event("empty", "name", "", "quote", `a"b`, "plain", "mul(2,4)")
+	tracer.Event("odd", "key")
+
+	want := `day 7 part 2: solved line=1 expression="10 * 19" ops=[*]
+day 7 part 2: empty name="" quote="a\"b" plain=mul(2,4)
+day 7 part 2: odd key=<nil>
+`
+	if buf.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := New(JSONLines(&buf), 5, 2)
+	tracer.Event("swap", "pages", []int{75, 97}, "rule", "97|75")
+	tracer.Event("done")
+
+	want := `{"day":5,"part":2,"event":"swap","pages":[75,97],"rule":"97|75"}
+{"day":5,"part":2,"event":"done"}
+`
+	if buf.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestNilTracer(t *testing.T) {
+	var tracer *Tracer
+	if tracer.Enabled() {
+		t.Error("nil tracer is enabled")
+	}
+	tracer.Event("ignored", "line", 1)
+	if err := tracer.Err(); err != nil {
+		t.Errorf("Err() = %v, want nil", err)
+	}
+}
+
+type failingSink struct{ calls int }
+
+func (s *failingSink) Emit(Event) error {
+	s.calls++
+	return errors.New("disk full")
+}
+
+func TestTracerStopsAfterError(t *testing.T) {
+	sink := &failingSink{}
+	tracer := New(sink, 1, 1)
+	tracer.Event("first")
+	tracer.Event("second")
+	if sink.calls != 1 {
+		t.Errorf("sink called %d times, want 1", sink.calls)
+	}
+	if err := tracer.Err(); err == nil || err.Error() != "disk full" {
+		t.Errorf("Err() = %v, want disk full", err)
+	}
+}
+
+func TestUnencodableField(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := New(JSONLines(&buf), 1, 1)
+	tracer.Event("bad", "f", func() {})
+	if tracer.Err() == nil {
+		t.Error("a func field was encoded without an error")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("wrote %q for a failed event", buf.String())
+	}
+}
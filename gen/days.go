@@ -0,0 +1,536 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/reckerp/aoc-2024/grid"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+var generators = map[int]map[int]Generator{
+	2024: {
+		1:  locationLists,
+		2:  reports,
+		3:  corruptedMemory,
+		4:  wordSearch,
+		5:  printQueue,
+		6:  labMap,
+		7:  calibrations,
+		8:  antennaMap,
+		9:  diskMap,
+		10: topographicMap,
+		11: stones,
+		12: garden,
+		13: clawMachines,
+		14: robots,
+		15: warehouse,
+		16: reindeerMaze,
+		17: program,
+		18: fallingBytes,
+	},
+}
+
+// between returns a random int in [lo, hi].
+func between(r *rand.Rand, lo, hi int) int {
+	return lo + r.IntN(hi-lo+1)
+}
+
+// cells returns a width by height block of lines whose cells are drawn by
+// cell.
+func cells(width, height int, cell func(p grid.Point) byte) []byte {
+	var buf bytes.Buffer
+	for y := range height {
+		for x := range width {
+			buf.WriteByte(cell(grid.Point{X: x, Y: y}))
+		}
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+// locationLists writes size pairs of location IDs. IDs are drawn from a
+// range small enough that the lists share some of them.
+func locationLists(r *rand.Rand, size int) Input {
+	var buf bytes.Buffer
+	for range size {
+		fmt.Fprintf(&buf, "%d   %d\n", between(r, 1, 2*size), between(r, 1, 2*size))
+	}
+	return Input{Data: buf.Bytes()}
+}
+
+// reports writes size reports. Most are safe runs, some with one bad level
+// inserted, so that both parts have something to count.
+func reports(r *rand.Rand, size int) Input {
+	var buf bytes.Buffer
+	for range size {
+		n := between(r, 1, 8)
+		levels := make([]int, n)
+		if r.IntN(4) == 0 {
+			for i := range levels {
+				levels[i] = between(r, 1, 99)
+			}
+		} else {
+			sign := 1 - 2*r.IntN(2)
+			levels[0] = between(r, 30, 60)
+			for i := 1; i < n; i++ {
+				levels[i] = levels[i-1] + sign*between(r, 1, 3)
+			}
+			if r.IntN(2) == 0 {
+				levels[r.IntN(n)] = between(r, 1, 99)
+			}
+		}
+		for i, level := range levels {
+			if i > 0 {
+				buf.WriteByte(' ')
+			}
+			fmt.Fprint(&buf, level)
+		}
+		buf.WriteByte('\n')
+	}
+	return Input{Data: buf.Bytes()}
+}
+
+// corruptedMemory writes size tokens: valid and mangled mul instructions,
+// do() and don't(), and junk, occasionally broken across lines.
+func corruptedMemory(r *rand.Rand, size int) Input {
+	const junk = "%&!@^*+-()[]<>?,:;' xdomult_"
+	num := func() string { return fmt.Sprint(between(r, 0, 999)) }
+
+	var buf bytes.Buffer
+	for range size {
+		switch r.IntN(8) {
+		case 0, 1:
+			fmt.Fprintf(&buf, "mul(%s,%s)", num(), num())
+		case 2:
+			mangled := []string{"mul[%s,%s]", "mul(%s,%s", "mul( %s,%s)", "mul(%s;%s)", "mul(%s,,%s)", "mul(%s1234,%s)"}
+			fmt.Fprintf(&buf, mangled[r.IntN(len(mangled))], num(), num())
+		case 3:
+			buf.WriteString("do()")
+		case 4:
+			buf.WriteString("don't()")
+		case 5:
+			buf.WriteString([]string{"do(", "don't", "don't(x)", "undo()", "mul"}[r.IntN(5)])
+		default:
+			for range between(r, 1, 4) {
+				buf.WriteByte(junk[r.IntN(len(junk))])
+			}
+		}
+		if r.IntN(20) == 0 {
+			buf.WriteByte('\n')
+		}
+	}
+	buf.WriteByte('\n')
+	return Input{Data: buf.Bytes()}
+}
+
+// wordSearch writes a size by size grid of the letters of XMAS.
+func wordSearch(r *rand.Rand, size int) Input {
+	return Input{Data: cells(size, size, func(grid.Point) byte { return "XMAS"[r.IntN(4)] })}
+}
+
+// printQueue writes rules for every pair of pages in a random order of up
+// to size pages, followed by size updates of an odd number of those pages,
+// about half of them in order.
+func printQueue(r *rand.Rand, size int) Input {
+	n := min(size+2, 90)
+	pages := r.Perm(90)[:n]
+	rank := make(map[int]int, n)
+	for i := range pages {
+		pages[i] += 10
+		rank[pages[i]] = i
+	}
+
+	var buf bytes.Buffer
+	for i := range pages {
+		for j := i + 1; j < n; j++ {
+			fmt.Fprintf(&buf, "%d|%d\n", pages[i], pages[j])
+		}
+	}
+	buf.WriteByte('\n')
+
+	for range size {
+		k := 2*between(r, 0, (min(n, 23)-1)/2) + 1
+		update := make([]int, k)
+		for i, idx := range r.Perm(n)[:k] {
+			update[i] = pages[idx]
+		}
+		if r.IntN(2) == 0 {
+			sortByRank(update, rank)
+		}
+		for i, page := range update {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			fmt.Fprint(&buf, page)
+		}
+		buf.WriteByte('\n')
+	}
+	return Input{Data: buf.Bytes()}
+}
+
+func sortByRank(pages []int, rank map[int]int) {
+	for i := 1; i < len(pages); i++ {
+		for j := i; j > 0 && rank[pages[j]] < rank[pages[j-1]]; j-- {
+			pages[j], pages[j-1] = pages[j-1], pages[j]
+		}
+	}
+}
+
+// labMap writes a lab of side size+2 with scattered obstructions and a
+// guard. The guard always walks off the map eventually, as the puzzle
+// promises, so maps where it would loop are redrawn.
+func labMap(r *rand.Rand, size int) Input {
+	side := size + 2
+	for {
+		guard := grid.Point{X: r.IntN(side), Y: r.IntN(side)}
+		dir := r.IntN(4)
+		data := cells(side, side, func(p grid.Point) byte {
+			switch {
+			case p == guard:
+				return "^>v<"[dir]
+			case r.IntN(8) == 0:
+				return '#'
+			}
+			return '.'
+		})
+		if guardLeaves(data, side, guard, dir) {
+			return Input{Data: data}
+		}
+	}
+}
+
+// guardLeaves reports whether the guard walks off a generated lab map
+// rather than patrolling in a loop.
+func guardLeaves(data []byte, side int, pos grid.Point, dir int) bool {
+	type state struct {
+		pos grid.Point
+		dir int
+	}
+	seen := make(map[state]bool)
+	for !seen[state{pos, dir}] {
+		seen[state{pos, dir}] = true
+		next := pos.Add(grid.Dirs4[dir])
+		if next.X < 0 || next.Y < 0 || next.X >= side || next.Y >= side {
+			return true
+		}
+		if data[next.Y*(side+1)+next.X] == '#' {
+			dir = (dir + 1) % 4
+			continue
+		}
+		pos = next
+	}
+	return false
+}
+
+// calibrations writes size equations of two to six numbers. About half of
+// the test values are made from the numbers with random operators, so that
+// both parts find some true equations. The numbers are small enough that no
+// combination of operators overflows.
+func calibrations(r *rand.Rand, size int) Input {
+	var buf bytes.Buffer
+	for range size {
+		numbers := make([]int, between(r, 2, 6))
+		for i := range numbers {
+			numbers[i] = between(r, 1, 99)
+		}
+
+		value := between(r, 1, 10000)
+		if r.IntN(2) == 0 {
+			value = numbers[0]
+			for _, n := range numbers[1:] {
+				switch r.IntN(3) {
+				case 0:
+					value += n
+				case 1:
+					value *= n
+				default:
+					value = value*pow10(n) + n
+				}
+			}
+		}
+
+		fmt.Fprintf(&buf, "%d:", value)
+		for _, n := range numbers {
+			fmt.Fprintf(&buf, " %d", n)
+		}
+		buf.WriteByte('\n')
+	}
+	return Input{Data: buf.Bytes()}
+}
+
+// pow10 returns the smallest power of ten greater than n.
+func pow10(n int) int {
+	p := 10
+	for p <= n {
+		p *= 10
+	}
+	return p
+}
+
+// antennaMap writes a size by size map with antennas of a few frequencies.
+func antennaMap(r *rand.Rand, size int) Input {
+	const frequencies = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	used := frequencies[r.IntN(len(frequencies)-3):]
+	used = used[:between(r, 1, min(3, len(used)))]
+	return Input{Data: cells(size, size, func(grid.Point) byte {
+		if r.IntN(10) == 0 {
+			return used[r.IntN(len(used))]
+		}
+		return '.'
+	})}
+}
+
+// diskMap writes a disk map of size files. Files take one to nine blocks
+// and the gaps between them zero to nine.
+func diskMap(r *rand.Rand, size int) Input {
+	var buf bytes.Buffer
+	for i := range size {
+		if i > 0 {
+			buf.WriteByte(byte('0' + between(r, 0, 9)))
+		}
+		buf.WriteByte(byte('0' + between(r, 1, 9)))
+	}
+	buf.WriteByte('\n')
+	return Input{Data: buf.Bytes()}
+}
+
+// topographicMap writes a size by size height map. Heights mostly step by
+// one from the cell above or to the left, so that trails are common.
+func topographicMap(r *rand.Rand, size int) Input {
+	heights := make([][]int, size)
+	for y := range heights {
+		heights[y] = make([]int, size)
+		for x := range heights[y] {
+			switch {
+			case r.IntN(4) == 0 || x+y == 0:
+				heights[y][x] = r.IntN(10)
+			case x > 0 && (y == 0 || r.IntN(2) == 0):
+				heights[y][x] = step(r, heights[y][x-1])
+			default:
+				heights[y][x] = step(r, heights[y-1][x])
+			}
+		}
+	}
+	return Input{Data: cells(size, size, func(p grid.Point) byte { return byte('0' + heights[p.Y][p.X]) })}
+}
+
+// step returns a height one above or below h, within 0 to 9.
+func step(r *rand.Rand, h int) int {
+	if h == 9 || (h > 0 && r.IntN(2) == 0) {
+		return h - 1
+	}
+	return h + 1
+}
+
+// stones writes size stones with up to four digits each. Part 2 blinks
+// 25 to 30 times rather than 75, few enough for the reference solution to
+// follow every stone.
+func stones(r *rand.Rand, size int) Input {
+	fields := make([]string, size)
+	for i := range fields {
+		fields[i] = fmt.Sprint(between(r, 0, 9999))
+	}
+	return Input{
+		Data:   []byte(strings.Join(fields, " ") + "\n"),
+		Params: solver.Params{"part2_blinks": between(r, 25, 30)},
+	}
+}
+
+// garden writes a size by size garden of a few plant types. Plots copy a
+// neighbour more often than not, so regions grow beyond single plots.
+func garden(r *rand.Rand, size int) Input {
+	kinds := between(r, 1, 5)
+	plots := make([][]byte, size)
+	for y := range plots {
+		plots[y] = make([]byte, size)
+		for x := range plots[y] {
+			switch {
+			case x > 0 && r.IntN(3) == 0:
+				plots[y][x] = plots[y][x-1]
+			case y > 0 && r.IntN(2) == 0:
+				plots[y][x] = plots[y-1][x]
+			default:
+				plots[y][x] = byte('A' + r.IntN(kinds))
+			}
+		}
+	}
+	return Input{Data: cells(size, size, func(p grid.Point) byte { return plots[p.Y][p.X] })}
+}
+
+// clawMachines writes size claw machines. About one in five has parallel
+// buttons, moving along a diagonal so that the prize offset keeps prizes on
+// it. About half of the prizes can be won with up to 200 presses of each
+// button, so some need more presses than part 1 allows. The prize offset of
+// part 2 is at most 10000 rather than 10^13, small enough for the reference
+// solution to try every number of presses.
+func clawMachines(r *rand.Rand, size int) Input {
+	var buf bytes.Buffer
+	for i := range size {
+		var ax, ay, bx, by int
+		px, py := between(r, 100, 20000), between(r, 100, 20000)
+		if r.IntN(5) == 0 {
+			d := between(r, 1, 9)
+			ax, bx = d*between(r, 1, 11), d*between(r, 1, 11)
+			ay, by, py = ax, bx, px
+		} else {
+			for ax*by == ay*bx {
+				ax, ay, bx, by = between(r, 1, 99), between(r, 1, 99), between(r, 1, 99), between(r, 1, 99)
+			}
+		}
+		if r.IntN(2) == 0 {
+			a, b := between(r, 0, 200), between(r, 0, 200)
+			px, py = a*ax+b*bx, a*ay+b*by
+		}
+		if i > 0 {
+			buf.WriteByte('\n')
+		}
+		fmt.Fprintf(&buf, "Button A: X+%d, Y+%d\nButton B: X+%d, Y+%d\nPrize: X=%d, Y=%d\n", ax, ay, bx, by, px, py)
+	}
+	return Input{Data: buf.Bytes(), Params: solver.Params{"prize_offset": between(r, 0, 10000)}}
+}
+
+// robots writes size robots on the puzzle's default 101 by 103 field.
+func robots(r *rand.Rand, size int) Input {
+	var buf bytes.Buffer
+	for range size {
+		fmt.Fprintf(&buf, "p=%d,%d v=%d,%d\n", r.IntN(101), r.IntN(103), between(r, -99, 99), between(r, -99, 99))
+	}
+	return Input{Data: buf.Bytes()}
+}
+
+// warehouse writes a walled warehouse of side size+4 with boxes, inner
+// walls and the robot, followed by 10*size moves.
+func warehouse(r *rand.Rand, size int) Input {
+	side := size + 4
+	robot := grid.Point{X: between(r, 1, side-2), Y: between(r, 1, side-2)}
+	data := cells(side, side, func(p grid.Point) byte {
+		switch {
+		case p.X == 0 || p.Y == 0 || p.X == side-1 || p.Y == side-1:
+			return '#'
+		case p == robot:
+			return '@'
+		}
+		switch n := r.IntN(10); {
+		case n == 0:
+			return '#'
+		case n < 4:
+			return 'O'
+		}
+		return '.'
+	})
+
+	buf := bytes.NewBuffer(data)
+	buf.WriteByte('\n')
+	for i := range 10 * size {
+		if i > 0 && i%70 == 0 {
+			buf.WriteByte('\n')
+		}
+		buf.WriteByte("^>v<"[r.IntN(4)])
+	}
+	buf.WriteByte('\n')
+	return Input{Data: buf.Bytes()}
+}
+
+// reindeerMaze writes a maze of size+1 by size+1 cells with walls between them,
+// carved by a random depth-first search and then opened up in places so
+// that some tiles can be reached in more than one way. S is in the bottom
+// left corner and E in the top right, as in the puzzle.
+func reindeerMaze(r *rand.Rand, size int) Input {
+	side := 2*size + 3
+	maze := make([][]byte, side)
+	for y := range maze {
+		maze[y] = bytes.Repeat([]byte{'#'}, side)
+	}
+
+	start := grid.Point{X: 1, Y: side - 2}
+	maze[start.Y][start.X] = '.'
+	stack := []grid.Point{start}
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		var next []grid.Point
+		for _, d := range grid.Dirs4 {
+			p := cur.Add(d).Add(d)
+			if p.X > 0 && p.Y > 0 && p.X < side-1 && p.Y < side-1 && maze[p.Y][p.X] == '#' {
+				next = append(next, p)
+			}
+		}
+		if len(next) == 0 {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		p := next[r.IntN(len(next))]
+		maze[(cur.Y+p.Y)/2][(cur.X+p.X)/2] = '.'
+		maze[p.Y][p.X] = '.'
+		stack = append(stack, p)
+	}
+
+	for range size * size / 4 {
+		x, y := between(r, 1, side-2), between(r, 1, side-2)
+		if (x+y)%2 == 1 {
+			maze[y][x] = '.'
+		}
+	}
+
+	maze[start.Y][start.X] = 'S'
+	maze[1][side-2] = 'E'
+	return Input{Data: cells(side, side, func(p grid.Point) byte { return maze[p.Y][p.X] })}
+}
+
+// program writes a 3-bit program shaped like the puzzle's: a loop that
+// derives B from the low bits of A, outputs B, shifts A right by three and
+// repeats until A is zero. Some are as short as the second example, short
+// enough for the reference solution of part 2 to try every value of A.
+// Register A holds up to 3*size bits, capped so that shifting it stays well
+// within an int64.
+func program(r *rand.Rand, size int) Input {
+	ops := []int{0, 3, 5, 4, 3, 0} // adv 3, out A, jnz 0
+	if r.IntN(4) > 0 {
+		ops = []int{2, 4} // bst A
+		body := [][]int{
+			{1, r.IntN(8)}, // bxl
+			{7, 5},         // cdv B
+			{1, r.IntN(8)}, // bxl
+			{4, r.IntN(8)}, // bxc
+		}
+		r.Shuffle(len(body)-1, func(i, j int) { body[i+1], body[j+1] = body[j+1], body[i+1] })
+		for _, op := range body[:between(r, 0, len(body))] {
+			ops = append(ops, op...)
+		}
+		ops = append(ops, 5, 5, 0, 3, 3, 0) // out B, adv 3, jnz 0
+	}
+
+	a := r.Int64N(int64(1)<<(3*min(size, 16))) + 1
+	fields := make([]string, len(ops))
+	for i, op := range ops {
+		fields[i] = fmt.Sprint(op)
+	}
+	data := fmt.Sprintf("Register A: %d\nRegister B: 0\nRegister C: 0\n\nProgram: %s\n", a, strings.Join(fields, ","))
+	return Input{Data: []byte(data)}
+}
+
+// fallingBytes writes every position of a memory space of side size+1
+// except the two corners, in random order, so that the path is cut off
+// eventually. Part 1 looks at the first third of them.
+func fallingBytes(r *rand.Rand, size int) Input {
+	var positions []grid.Point
+	for y := range size + 1 {
+		for x := range size + 1 {
+			if (x != 0 || y != 0) && (x != size || y != size) {
+				positions = append(positions, grid.Point{X: x, Y: y})
+			}
+		}
+	}
+	r.Shuffle(len(positions), func(i, j int) { positions[i], positions[j] = positions[j], positions[i] })
+
+	var buf bytes.Buffer
+	for _, p := range positions {
+		fmt.Fprintf(&buf, "%d,%d\n", p.X, p.Y)
+	}
+	return Input{
+		Data:   buf.Bytes(),
+		Params: solver.Params{"size": size, "bytes": len(positions) / 3},
+	}
+}
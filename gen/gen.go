@@ -0,0 +1,56 @@
+// Package gen generates random puzzle inputs that are valid for each day,
+// for testing the solvers beyond the single real input. A generator is
+// deterministic in its seed, so any input it produces can be recreated from
+// the year, day, size and seed alone.
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sort"
+
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+// Input is a generated puzzle input.
+type Input struct {
+	Data []byte
+	// Params overrides the day's defaults where the input needs it, such
+	// as the memory size of day 18. It is nil for most days.
+	Params solver.Params
+}
+
+// Generator produces a random input for a day. Size roughly scales the
+// input, as the number of lines or the side of a grid, and is at least 1.
+type Generator func(r *rand.Rand, size int) Input
+
+// The generators map lives in days.go.
+
+// Lookup returns the generator registered for the given day of a year.
+func Lookup(year, day int) (Generator, bool) {
+	g, ok := generators[year][day]
+	return g, ok
+}
+
+// Days returns every day of a year that has a generator in ascending order.
+func Days(year int) []int {
+	result := make([]int, 0, len(generators[year]))
+	for day := range generators[year] {
+		result = append(result, day)
+	}
+	sort.Ints(result)
+	return result
+}
+
+// Generate returns the input of the given size and seed for a day of a
+// year.
+func Generate(year, day, size int, seed uint64) (Input, error) {
+	g, ok := Lookup(year, day)
+	if !ok {
+		return Input{}, fmt.Errorf("no generator for %d day %d", year, day)
+	}
+	if size < 1 {
+		return Input{}, fmt.Errorf("invalid size: %d", size)
+	}
+	return g(rand.New(rand.NewPCG(seed, uint64(day))), size), nil
+}
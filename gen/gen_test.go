@@ -0,0 +1,57 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/registry"
+)
+
+func TestGeneratedInputsParse(t *testing.T) {
+	for _, day := range Days(2024) {
+		s, ok := registry.Lookup(2024, day)
+		if !ok {
+			t.Fatalf("day %d has a generator but no solver", day)
+		}
+		for _, size := range []int{1, 2, 5, 20} {
+			for seed := range uint64(5) {
+				t.Run(fmt.Sprintf("day%02d/size%d/seed%d", day, size, seed), func(t *testing.T) {
+					in, err := Generate(2024, day, size, seed)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if _, err := s.Parse(bytes.NewReader(in.Data)); err != nil {
+						t.Errorf("Parse: %v\ninput:\n%s", err, in.Data)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	for _, day := range Days(2024) {
+		a, _ := Generate(2024, day, 8, 42)
+		b, _ := Generate(2024, day, 8, 42)
+		if !bytes.Equal(a.Data, b.Data) || a.Params.String() != b.Params.String() {
+			t.Errorf("day %d: two inputs from the same seed differ", day)
+		}
+		c, _ := Generate(2024, day, 8, 43)
+		if bytes.Equal(a.Data, c.Data) {
+			t.Errorf("day %d: seeds 42 and 43 gave the same input", day)
+		}
+	}
+}
+
+func TestGenerateRejects(t *testing.T) {
+	if _, err := Generate(2024, 26, 5, 1); err == nil {
+		t.Error("expected an error for a day without a generator")
+	}
+	if _, err := Generate(2025, 1, 5, 1); err == nil {
+		t.Error("expected an error for a year without generators")
+	}
+	if _, err := Generate(2024, 1, 0, 1); err == nil {
+		t.Error("expected an error for size 0")
+	}
+}
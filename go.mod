@@ -0,0 +1,3 @@
+module github.com/reckerp/aoc-2024
+
+go 1.23.3
@@ -0,0 +1,237 @@
+// Package grid provides a generic two-dimensional grid for the puzzles whose
+// input is a map of characters.
+package grid
+
+import (
+	"io"
+	"iter"
+	"strings"
+
+	"github.com/reckerp/aoc-2024/parse"
+)
+
+// Point is a cell position. X is the column and Y the row, both 0-based with
+// the origin in the top-left corner.
+type Point struct {
+	X, Y int
+}
+
+// Add returns p moved by d.
+func (p Point) Add(d Point) Point {
+	return Point{p.X + d.X, p.Y + d.Y}
+}
+
+// Sub returns the offset from q to p.
+func (p Point) Sub(q Point) Point {
+	return Point{p.X - q.X, p.Y - q.Y}
+}
+
+// Scale returns p with both coordinates multiplied by k.
+func (p Point) Scale(k int) Point {
+	return Point{p.X * k, p.Y * k}
+}
+
+// Unit offsets for the four cardinal directions.
+var (
+	Up    = Point{0, -1}
+	Right = Point{1, 0}
+	Down  = Point{0, 1}
+	Left  = Point{-1, 0}
+)
+
+// Dirs4 lists the cardinal directions clockwise, starting with Up.
+var Dirs4 = []Point{Up, Right, Down, Left}
+
+// Dirs8 lists the cardinal and diagonal directions clockwise, starting with
+// Up.
+var Dirs8 = []Point{Up, {1, -1}, Right, {1, 1}, Down, {-1, 1}, Left, {-1, -1}}
+
+// Grid is a rectangular grid of cells stored row by row.
+type Grid[T any] struct {
+	width, height int
+	cells         []T
+}
+
+// New returns a width by height grid of zero cells.
+func New[T any](width, height int) *Grid[T] {
+	return &Grid[T]{width: width, height: height, cells: make([]T, width*height)}
+}
+
+// FromLines builds a grid from rows of equal length, converting each
+// character with cell. The rows are usually validated by parse.Grid first.
+func FromLines[T any](lines []string, cell func(rune) T) *Grid[T] {
+	if len(lines) == 0 {
+		return New[T](0, 0)
+	}
+	g := New[T](len([]rune(lines[0])), len(lines))
+	for y, line := range lines {
+		x := 0
+		for _, r := range line {
+			g.cells[y*g.width+x] = cell(r)
+			x++
+		}
+	}
+	return g
+}
+
+// Parse reads an input that consists of a single grid, restricted to the
+// characters in allowed as described by parse.Grid.
+func Parse[T any](r io.Reader, allowed string, cell func(rune) T) (*Grid[T], error) {
+	lines, err := parse.Grid(r, allowed)
+	if err != nil {
+		return nil, err
+	}
+	return FromLines(lines, cell), nil
+}
+
+// Scan reads the next grid section from s, leaving the rest of the input
+// for the caller.
+func Scan[T any](s *parse.Scanner, allowed string, cell func(rune) T) (*Grid[T], error) {
+	lines, err := s.Grid(allowed)
+	if err != nil {
+		return nil, err
+	}
+	return FromLines(lines, cell), nil
+}
+
+// Rune is the identity cell conversion, for grids of characters.
+func Rune(r rune) rune {
+	return r
+}
+
+// Width returns the number of columns.
+func (g *Grid[T]) Width() int {
+	return g.width
+}
+
+// Height returns the number of rows.
+func (g *Grid[T]) Height() int {
+	return g.height
+}
+
+// In reports whether p lies inside the grid.
+func (g *Grid[T]) In(p Point) bool {
+	return p.X >= 0 && p.X < g.width && p.Y >= 0 && p.Y < g.height
+}
+
+// At returns the cell at p, which must lie inside the grid.
+func (g *Grid[T]) At(p Point) T {
+	return g.cells[p.Y*g.width+p.X]
+}
+
+// Get returns the cell at p and whether p lies inside the grid.
+func (g *Grid[T]) Get(p Point) (T, bool) {
+	if !g.In(p) {
+		var zero T
+		return zero, false
+	}
+	return g.At(p), true
+}
+
+// Set stores v in the cell at p, which must lie inside the grid.
+func (g *Grid[T]) Set(p Point, v T) {
+	g.cells[p.Y*g.width+p.X] = v
+}
+
+// All iterates over every cell in row-major order.
+func (g *Grid[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for i, v := range g.cells {
+			if !yield(Point{i % g.width, i / g.width}, v) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbours4 iterates over the in-bounds cardinal neighbours of p.
+func (g *Grid[T]) Neighbours4(p Point) iter.Seq[Point] {
+	return g.neighbours(p, Dirs4)
+}
+
+// Neighbours8 iterates over the in-bounds cardinal and diagonal neighbours
+// of p.
+func (g *Grid[T]) Neighbours8(p Point) iter.Seq[Point] {
+	return g.neighbours(p, Dirs8)
+}
+
+func (g *Grid[T]) neighbours(p Point, dirs []Point) iter.Seq[Point] {
+	return func(yield func(Point) bool) {
+		for _, d := range dirs {
+			if n := p.Add(d); g.In(n) && !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+// Find returns the first cell in row-major order that matches.
+func (g *Grid[T]) Find(match func(T) bool) (Point, bool) {
+	for p, v := range g.All() {
+		if match(v) {
+			return p, true
+		}
+	}
+	return Point{}, false
+}
+
+// FindAll returns every cell that matches, in row-major order.
+func (g *Grid[T]) FindAll(match func(T) bool) []Point {
+	var points []Point
+	for p, v := range g.All() {
+		if match(v) {
+			points = append(points, p)
+		}
+	}
+	return points
+}
+
+// Clone returns a copy of the grid that shares no cells with it.
+func (g *Grid[T]) Clone() *Grid[T] {
+	c := *g
+	c.cells = make([]T, len(g.cells))
+	copy(c.cells, g.cells)
+	return &c
+}
+
+// Transpose returns a copy of the grid mirrored along its main diagonal.
+func (g *Grid[T]) Transpose() *Grid[T] {
+	t := New[T](g.height, g.width)
+	for p, v := range g.All() {
+		t.Set(Point{p.Y, p.X}, v)
+	}
+	return t
+}
+
+// Rotate returns a copy of the grid turned 90 degrees clockwise.
+func (g *Grid[T]) Rotate() *Grid[T] {
+	r := New[T](g.height, g.width)
+	for p, v := range g.All() {
+		r.Set(Point{g.height - 1 - p.Y, p.X}, v)
+	}
+	return r
+}
+
+// Render draws the grid as text, one line per row, converting each cell
+// with cell.
+func (g *Grid[T]) Render(cell func(Point, T) rune) string {
+	var b strings.Builder
+	for p, v := range g.All() {
+		b.WriteRune(cell(p, v))
+		if p.X == g.width-1 {
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+// Cell draws a rune grid as it is, for use with Render.
+func Cell(_ Point, r rune) rune {
+	return r
+}
+
+// Equal returns a matcher for cells equal to want, for use with Find and
+// FindAll.
+func Equal[T comparable](want T) func(T) bool {
+	return func(v T) bool { return v == want }
+}
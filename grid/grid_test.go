@@ -0,0 +1,108 @@
+package grid
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func render(g *Grid[rune]) string {
+	return g.Render(func(_ Point, r rune) rune { return r })
+}
+
+func parseRunes(t *testing.T, s string) *Grid[rune] {
+	t.Helper()
+	g, err := Parse(strings.NewReader(s), "", Rune)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestParseAndAccess(t *testing.T) {
+	g := parseRunes(t, "abc\ndef\n")
+	if g.Width() != 3 || g.Height() != 2 {
+		t.Fatalf("size = %dx%d, want 3x2", g.Width(), g.Height())
+	}
+	if got := g.At(Point{2, 1}); got != 'f' {
+		t.Errorf("At(2,1) = %c, want f", got)
+	}
+	if _, ok := g.Get(Point{3, 0}); ok {
+		t.Error("Get(3,0) reported in bounds")
+	}
+	if _, ok := g.Get(Point{0, -1}); ok {
+		t.Error("Get(0,-1) reported in bounds")
+	}
+
+	g.Set(Point{0, 0}, 'x')
+	if got, want := render(g), "xbc\ndef\n"; got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	if _, err := Parse(strings.NewReader("ab\nc\n"), "", Rune); err == nil {
+		t.Fatal("Parse accepted a ragged grid")
+	}
+	// Rows are as wide as their rune count, not their length in bytes.
+	if _, err := Parse(strings.NewReader("0ف0\n0000\n"), "", Rune); err == nil {
+		t.Fatal("Parse accepted a ragged grid of multi-byte runes")
+	}
+	if g := parseRunes(t, "é.\n..\n"); g.Width() != 2 || g.At(Point{0, 0}) != 'é' {
+		t.Errorf("multi-byte grid = %q, want 2 cells per row", render(g))
+	}
+}
+
+func TestNeighbours(t *testing.T) {
+	g := New[int](3, 3)
+
+	tests := []struct {
+		name string
+		got  func(Point) []Point
+		p    Point
+		want []Point
+	}{
+		{"4 corner", func(p Point) []Point { return slices.Collect(g.Neighbours4(p)) }, Point{0, 0}, []Point{{1, 0}, {0, 1}}},
+		{"4 centre", func(p Point) []Point { return slices.Collect(g.Neighbours4(p)) }, Point{1, 1}, []Point{{1, 0}, {2, 1}, {1, 2}, {0, 1}}},
+		{"8 corner", func(p Point) []Point { return slices.Collect(g.Neighbours8(p)) }, Point{2, 2}, []Point{{2, 1}, {1, 2}, {1, 1}}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got(tt.p); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: neighbours of %v = %v, want %v", tt.name, tt.p, got, tt.want)
+		}
+	}
+	if n := len(slices.Collect(g.Neighbours8(Point{1, 1}))); n != 8 {
+		t.Errorf("centre has %d 8-way neighbours, want 8", n)
+	}
+}
+
+func TestFind(t *testing.T) {
+	g := parseRunes(t, ".#.\n#..\n")
+	if p, ok := g.Find(Equal('#')); !ok || p != (Point{1, 0}) {
+		t.Errorf("Find(#) = %v, %v, want {1 0}, true", p, ok)
+	}
+	if _, ok := g.Find(Equal('@')); ok {
+		t.Error("Find(@) found a cell")
+	}
+	if got, want := g.FindAll(Equal('#')), []Point{{1, 0}, {0, 1}}; !slices.Equal(got, want) {
+		t.Errorf("FindAll(#) = %v, want %v", got, want)
+	}
+}
+
+func TestTransformations(t *testing.T) {
+	g := parseRunes(t, "abc\ndef\n")
+
+	if got, want := render(g.Transpose()), "ad\nbe\ncf\n"; got != want {
+		t.Errorf("Transpose() = %q, want %q", got, want)
+	}
+	if got, want := render(g.Rotate()), "da\neb\nfc\n"; got != want {
+		t.Errorf("Rotate() = %q, want %q", got, want)
+	}
+
+	c := g.Clone()
+	c.Set(Point{0, 0}, 'z')
+	if g.At(Point{0, 0}) != 'a' {
+		t.Error("Clone shares cells with the original")
+	}
+}
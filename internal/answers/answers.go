@@ -0,0 +1,58 @@
+// Package answers stores the accepted answer for each part of each day of
+// every year.
+package answers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Answers maps year -> day -> part -> accepted answer.
+type Answers map[int]map[int]map[int]string
+
+// Load reads the answers file at path. A missing file yields an empty set.
+func Load(path string) (Answers, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Answers{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ans := Answers{}
+	if err := json.Unmarshal(data, &ans); err != nil {
+		return nil, fmt.Errorf("invalid answers file %s: %w", path, err)
+	}
+	return ans, nil
+}
+
+// Save writes the answers to path as indented JSON.
+func (a Answers) Save(path string) error {
+	data, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Get returns the accepted answer for a part of a day. An empty answer is
+// a placeholder for a part that has not been solved yet and is reported as
+// missing.
+func (a Answers) Get(year, day, part int) (string, bool) {
+	answer := a[year][day][part]
+	return answer, answer != ""
+}
+
+// Set records the accepted answer for a part of a day.
+func (a Answers) Set(year, day, part int, answer string) {
+	if a[year] == nil {
+		a[year] = make(map[int]map[int]string)
+	}
+	if a[year][day] == nil {
+		a[year][day] = make(map[int]string)
+	}
+	a[year][day][part] = answer
+}
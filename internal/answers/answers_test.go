@@ -0,0 +1,86 @@
+package answers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.json")
+
+	ans, err := Load(path)
+	if err != nil {
+		t.Fatalf("loading missing file: %v", err)
+	}
+	ans.Set(2024, 7, 2, "11387")
+	if err := ans.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := loaded.Get(2024, 7, 2); !ok || got != "11387" {
+		t.Errorf("Get(2024, 7, 2) = %q, %v; want 11387, true", got, ok)
+	}
+	if _, ok := loaded.Get(2024, 7, 1); ok {
+		t.Error("Get(2024, 7, 1) found an answer that was never set")
+	}
+	if _, ok := loaded.Get(2025, 7, 2); ok {
+		t.Error("Get(2025, 7, 2) found another year's answer")
+	}
+}
+
+func TestSaveKeepsPlaceholders(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.json")
+	stored := `{"2024": {"7": {"1": "3749", "2": "11387"}, "19": {"1": ""}}}`
+	if err := os.WriteFile(path, []byte(stored), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ans, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := ans.Get(2024, 7, 2); !ok || got != "11387" {
+		t.Errorf("Get(2024, 7, 2) = %q, %v; want 11387, true", got, ok)
+	}
+	if answer, ok := ans[2024][19][1]; !ok || answer != "" {
+		t.Errorf("placeholder for 2024 day 19 part 1 = %q, %v, want kept", answer, ok)
+	}
+
+	if err := ans.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{
+  "2024": {
+    "19": {
+      "1": ""
+    },
+    "7": {
+      "1": "3749",
+      "2": "11387"
+    }
+  }
+}
+`
+	if string(data) != want {
+		t.Errorf("saved\n%s\nwant\n%s", data, want)
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.json")
+	if err := os.WriteFile(path, []byte(`{"2024": ["7"]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("Load accepted a year holding a list")
+	}
+}
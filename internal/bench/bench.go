@@ -0,0 +1,169 @@
+// Package bench times the parse, part 1 and part 2 phases of a solver
+// separately and writes the results as a table, JSON or CSV.
+package bench
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+// Result holds the fastest observed duration of each phase for one day.
+type Result struct {
+	Year  int           `json:"year"`
+	Day   int           `json:"day"`
+	Runs  int           `json:"runs"`
+	Parse time.Duration `json:"parse_ns"`
+	Part1 time.Duration `json:"part1_ns"`
+	Part2 time.Duration `json:"part2_ns"`
+	Error string        `json:"error,omitempty"`
+}
+
+// Total is the combined duration of all three phases.
+func (r Result) Total() time.Duration {
+	return r.Parse + r.Part1 + r.Part2
+}
+
+// Report is a set of results captured at one point in time.
+type Report struct {
+	Time    time.Time `json:"time"`
+	Results []Result  `json:"results"`
+}
+
+// Day runs every phase of s, the solver of a day of a year, against the
+// input at path, solving with params, runs times and keeps the fastest
+// duration of each phase. The input is read from disk once so file I/O is
+// not part of the parse timing.
+func Day(year, day int, s solver.Solver, path string, params solver.Params, runs int) Result {
+	result := Result{Year: year, Day: day, Runs: runs}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	for i := 0; i < runs; i++ {
+		start := time.Now()
+		input, err := s.Parse(bytes.NewReader(data))
+		parse := time.Since(start)
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+
+		part1, err := timePart(s, 1, input, params)
+		if err != nil {
+			result.Error = fmt.Sprintf("part 1: %v", err)
+			return result
+		}
+
+		part2, err := timePart(s, 2, input, params)
+		if err != nil {
+			result.Error = fmt.Sprintf("part 2: %v", err)
+			return result
+		}
+
+		if i == 0 {
+			result.Parse, result.Part1, result.Part2 = parse, part1, part2
+			continue
+		}
+		result.Parse = min(result.Parse, parse)
+		result.Part1 = min(result.Part1, part1)
+		result.Part2 = min(result.Part2, part2)
+	}
+	return result
+}
+
+func timePart(s solver.Solver, part int, input any, params solver.Params) (time.Duration, error) {
+	start := time.Now()
+	_, err := s.Solve(part, input, params)
+	return time.Since(start), err
+}
+
+// WriteTable prints the report as an aligned, human-readable table.
+func WriteTable(w io.Writer, report Report) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "DAY\tPARSE\tPART 1\tPART 2\tTOTAL\t")
+	for _, r := range report.Results {
+		if r.Error != "" {
+			fmt.Fprintf(tw, "%d\t-\t-\t-\t-\t %s\n", r.Day, r.Error)
+			continue
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t\n", r.Day, Round(r.Parse), Round(r.Part1), Round(r.Part2), Round(r.Total()))
+	}
+	return tw.Flush()
+}
+
+// Round trims durations to three or four significant digits for display.
+func Round(d time.Duration) time.Duration {
+	switch {
+	case d >= time.Second:
+		return d.Round(time.Millisecond)
+	case d >= time.Millisecond:
+		return d.Round(time.Microsecond)
+	}
+	return d
+}
+
+// WriteJSON writes the report as indented JSON.
+func WriteJSON(w io.Writer, report Report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+// LoadReports reads every JSON report whose path matches pattern, as
+// written by WriteJSON, and returns them oldest first.
+func LoadReports(pattern string) ([]Report, error) {
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []Report
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var report Report
+		if err := json.Unmarshal(data, &report); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		reports = append(reports, report)
+	}
+	slices.SortStableFunc(reports, func(a, b Report) int { return a.Time.Compare(b.Time) })
+	return reports, nil
+}
+
+// WriteCSV writes one row per day, with durations in nanoseconds.
+func WriteCSV(w io.Writer, report Report) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"time", "year", "day", "runs", "parse_ns", "part1_ns", "part2_ns", "error"})
+	timestamp := report.Time.Format(time.RFC3339)
+	for _, r := range report.Results {
+		cw.Write([]string{
+			timestamp,
+			strconv.Itoa(r.Year),
+			strconv.Itoa(r.Day),
+			strconv.Itoa(r.Runs),
+			strconv.FormatInt(r.Parse.Nanoseconds(), 10),
+			strconv.FormatInt(r.Part1.Nanoseconds(), 10),
+			strconv.FormatInt(r.Part2.Nanoseconds(), 10),
+			r.Error,
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,78 @@
+package bench
+
+import (
+	"bytes"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/reckerp/aoc-2024/d01"
+)
+
+func TestDay(t *testing.T) {
+	path := filepath.Join("..", "..", "d01", "testdata", "example.txt")
+	result := Day(2024, 1, d01.Solver, path, nil, 3)
+	if result.Error != "" {
+		t.Fatalf("unexpected error: %s", result.Error)
+	}
+	if result.Year != 2024 || result.Runs != 3 || result.Parse <= 0 || result.Part1 <= 0 || result.Part2 <= 0 {
+		t.Errorf("incomplete result: %+v", result)
+	}
+
+	missing := Day(2024, 1, d01.Solver, filepath.Join(t.TempDir(), "input.txt"), nil, 1)
+	if missing.Error == "" {
+		t.Error("expected an error for a missing input file")
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	report := Report{
+		Time: time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+		Results: []Result{
+			{Year: 2024, Day: 6, Runs: 1, Parse: 10, Part1: 20, Part2: 30},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, report); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"2024-12-01T00:00:00Z", "2024", "6", "1", "10", "20", "30", ""}
+	if len(records) != 2 || !slices.Equal(records[1], want) {
+		t.Errorf("got %q, want header and %q", records, want)
+	}
+}
+
+func TestLoadReports(t *testing.T) {
+	dir := t.TempDir()
+	newer := Report{Time: time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC), Results: []Result{{Day: 1, Runs: 5, Parse: 2}}}
+	older := Report{Time: time.Date(2024, 12, 10, 0, 0, 0, 0, time.UTC), Results: []Result{{Day: 1, Runs: 5, Parse: 3}}}
+	for name, report := range map[string]Report{"a.json": newer, "b.json": older} {
+		var buf bytes.Buffer
+		if err := WriteJSON(&buf, report); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), buf.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	reports, err := LoadReports(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 2 || !reports[0].Time.Equal(older.Time) || !reports[1].Time.Equal(newer.Time) {
+		t.Fatalf("LoadReports() = %+v, want the older report first", reports)
+	}
+	if reports[1].Results[0].Parse != 2 {
+		t.Errorf("durations not read back: %+v", reports[1].Results)
+	}
+}
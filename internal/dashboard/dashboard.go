@@ -0,0 +1,311 @@
+// Package dashboard serves a local web page listing every day's answers,
+// verification status and benchmark history for one year, with a page per
+// day showing its visuals. Templates and assets are embedded, so it works
+// offline.
+package dashboard
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/reckerp/aoc-2024/internal/answers"
+	"github.com/reckerp/aoc-2024/internal/bench"
+	"github.com/reckerp/aoc-2024/internal/params"
+	"github.com/reckerp/aoc-2024/internal/verify"
+	"github.com/reckerp/aoc-2024/registry"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+var (
+	//go:embed templates/*.html
+	templateFS embed.FS
+	//go:embed static
+	staticFS embed.FS
+)
+
+var templates = template.Must(template.New("").Funcs(template.FuncMap{
+	"round":     bench.Round,
+	"sparkline": sparkline,
+}).ParseFS(templateFS, "templates/*.html"))
+
+// Server serves the dashboard of one year for the repository at Root.
+type Server struct {
+	root         string
+	year         int
+	answersPath  string
+	benchPattern string
+	mux          *http.ServeMux
+
+	mu sync.Mutex
+	// verified caches verification results by day until a re-check is
+	// requested, because re-solving every day on each page view is slow.
+	verified map[int][]verify.Result
+}
+
+// New returns a server for the days of year in the repository at root that
+// compares answers with the answers file at answersPath and reads the
+// benchmark history from the JSON reports matching benchPattern.
+func New(root string, year int, answersPath, benchPattern string) *Server {
+	s := &Server{
+		root:         root,
+		year:         year,
+		answersPath:  answersPath,
+		benchPattern: benchPattern,
+		mux:          http.NewServeMux(),
+		verified:     make(map[int][]verify.Result),
+	}
+	static, _ := fs.Sub(staticFS, "static")
+	s.mux.Handle("GET /static/", http.StripPrefix("/static/", http.FileServerFS(static)))
+	s.mux.HandleFunc("GET /{$}", s.index)
+	s.mux.HandleFunc("GET /day/{day}", s.day)
+	s.mux.HandleFunc("POST /verify", s.verify)
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// sample is one day's timings from one benchmark report.
+type sample struct {
+	Time   time.Time
+	Result bench.Result
+}
+
+type dayRow struct {
+	Year       int
+	Day        int
+	Parts      []verify.Result
+	History    []sample
+	HasVisuals bool
+}
+
+// Latest returns the most recent successful benchmark of the day, or nil
+// if there is none.
+func (d dayRow) Latest() *bench.Result {
+	for i := len(d.History) - 1; i >= 0; i-- {
+		if d.History[i].Result.Error == "" {
+			return &d.History[i].Result
+		}
+	}
+	return nil
+}
+
+func (s *Server) index(w http.ResponseWriter, r *http.Request) {
+	history, err := s.history()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	page := indexPage{Year: s.year}
+	for _, day := range registry.Days(s.year) {
+		parts, err := s.verifyDay(day)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		sol, _ := registry.Lookup(s.year, day)
+		page.Rows = append(page.Rows, dayRow{Year: s.year, Day: day, Parts: parts, History: history[day], HasVisuals: hasVisuals(sol)})
+	}
+	render(w, "index.html", page)
+}
+
+type indexPage struct {
+	Year int
+	Rows []dayRow
+}
+
+// Title returns the title of the page, which the layout completes with the
+// year.
+func (p indexPage) Title() string {
+	return "Dashboard"
+}
+
+type dayPage struct {
+	dayRow
+	// Input names the input the visuals were drawn from.
+	Input     string
+	Visuals   []solver.Visual
+	VisualErr string
+}
+
+func (p dayPage) Title() string {
+	return fmt.Sprintf("Day %d", p.Day)
+}
+
+func (s *Server) day(w http.ResponseWriter, r *http.Request) {
+	day, err := strconv.Atoi(r.PathValue("day"))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	sol, ok := registry.Lookup(s.year, day)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	history, err := s.history()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	parts, err := s.verifyDay(day)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	page := dayPage{dayRow: dayRow{Year: s.year, Day: day, Parts: parts, History: history[day], HasVisuals: hasVisuals(sol)}}
+	if page.HasVisuals {
+		page.Input, page.Visuals, err = s.visualize(day, sol, r.FormValue("input") == "example")
+		if err != nil {
+			page.VisualErr = err.Error()
+		}
+	}
+	render(w, "day.html", page)
+}
+
+// visualize draws a day from its puzzle input, or from its example when
+// asked to or when the input is missing.
+func (s *Server) visualize(day int, sol solver.Solver, example bool) (string, []solver.Visual, error) {
+	name := registry.InputPath(s.year, day)
+	data, err := os.ReadFile(filepath.Join(s.root, name))
+	if example || errors.Is(err, os.ErrNotExist) {
+		var ok bool
+		if data, ok = sol.Example(1); !ok {
+			return "", nil, fmt.Errorf("day %d has no input file and no embedded example", day)
+		}
+		name, err = "example", nil
+	}
+	if err != nil {
+		return name, nil, err
+	}
+
+	input, err := sol.Parse(bytes.NewReader(data))
+	if err != nil {
+		return name, nil, err
+	}
+	cfg, err := params.Load(filepath.Join(s.root, params.File))
+	if err != nil {
+		return name, nil, err
+	}
+	visuals, err := sol.Visualize(input, cfg.For(sol, s.year, day, name == "example", nil))
+	return name, visuals, err
+}
+
+func (s *Server) verify(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	clear(s.verified)
+	s.mu.Unlock()
+
+	back := "/"
+	if day := r.FormValue("day"); day != "" {
+		back = "/day/" + day
+	}
+	http.Redirect(w, r, back, http.StatusSeeOther)
+}
+
+// verifyDay returns the cached verification of a day, verifying it first
+// if needed.
+func (s *Server) verifyDay(day int) ([]verify.Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if results, ok := s.verified[day]; ok {
+		return results, nil
+	}
+	ans, err := answers.Load(s.answersPath)
+	if err != nil {
+		return nil, err
+	}
+	results := verify.Day(s.root, s.year, day, ans)
+	for i, r := range results {
+		// Show the stored answer even when the day could not be solved.
+		if r.Want == "" {
+			results[i].Want, _ = ans.Get(s.year, day, r.Part)
+		}
+	}
+	s.verified[day] = results
+	return results, nil
+}
+
+// history returns the benchmark results of each day of the year, oldest
+// first. Reports are read on every request so new benchmarks show up
+// without a restart.
+func (s *Server) history() (map[int][]sample, error) {
+	if s.benchPattern == "" {
+		return nil, nil
+	}
+	reports, err := bench.LoadReports(s.benchPattern)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make(map[int][]sample)
+	for _, report := range reports {
+		for _, result := range report.Results {
+			if result.Year != s.year {
+				continue
+			}
+			history[result.Day] = append(history[result.Day], sample{Time: report.Time, Result: result})
+		}
+	}
+	return history, nil
+}
+
+// hasVisuals reports whether a day draws anything. Visualize rejects days
+// without visuals before it looks at the model, so none is needed.
+func hasVisuals(sol solver.Solver) bool {
+	_, err := sol.Visualize(nil, nil)
+	return !errors.Is(err, solver.ErrNoVisuals)
+}
+
+// sparkline returns the points of an SVG polyline plotting the total time
+// of each successful benchmark in a 100 by 20 box.
+func sparkline(history []sample) string {
+	var totals []time.Duration
+	for _, h := range history {
+		if h.Result.Error == "" {
+			totals = append(totals, h.Result.Total())
+		}
+	}
+	if len(totals) < 2 {
+		return ""
+	}
+
+	highest := slices.Max(totals)
+	points := make([]string, len(totals))
+	for i, total := range totals {
+		x := float64(i) * 100 / float64(len(totals)-1)
+		y := 20.0
+		if highest > 0 {
+			y -= float64(total) * 20 / float64(highest)
+		}
+		points[i] = fmt.Sprintf("%.1f,%.1f", x, y)
+	}
+	return strings.Join(points, " ")
+}
+
+func render(w http.ResponseWriter, name string, data any) {
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, name, data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(buf.Bytes())
+}
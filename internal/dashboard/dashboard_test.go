@@ -0,0 +1,120 @@
+package dashboard
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reckerp/aoc-2024/internal/bench"
+)
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "answers.json"), []byte(`{"2024": {"1": {"1": "11"}}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Mkdir(filepath.Join(root, "bench"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	report, err := os.Create(filepath.Join(root, "bench", "report.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer report.Close()
+	err = bench.WriteJSON(report, bench.Report{
+		Time: time.Date(2024, 12, 24, 9, 30, 0, 0, time.UTC),
+		Results: []bench.Result{
+			{Year: 2024, Day: 6, Runs: 5, Parse: time.Millisecond, Part1: 2 * time.Millisecond},
+			{Year: 2025, Day: 6, Runs: 5, Parse: time.Millisecond, Part1: 6 * time.Millisecond},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return New(root, 2024, filepath.Join(root, "answers.json"), filepath.Join(root, "bench", "*.json"))
+}
+
+func get(t *testing.T, s *Server, method, target string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+	return rec
+}
+
+func TestPages(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		target string
+		code   int
+		want   []string
+		absent []string
+	}{
+		{"/", http.StatusOK, []string{"Advent of Code 2024", `<a href="/day/1">1</a>`, `<a href="/day/18">18</a>`, "3ms"}, []string{"7ms"}},
+		{"/day/6", http.StatusOK, []string{"Day 6 · Advent of Code 2024", "Guard route", "Drawn from example", "2024-12-24 09:30", "no input file"}, nil},
+		{"/day/16", http.StatusOK, []string{"Best paths"}, nil},
+		{"/day/1", http.StatusOK, []string{"no input file", `<td class="answer">11</td>`}, []string{"Visuals"}},
+		{"/day/99", http.StatusNotFound, nil, nil},
+		{"/day/x", http.StatusNotFound, nil, nil},
+		{"/static/app.js", http.StatusOK, []string{"canvas"}, nil},
+		{"/static/style.css", http.StatusOK, []string{"pre.visual"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			rec := get(t, s, http.MethodGet, tt.target)
+			if rec.Code != tt.code {
+				t.Fatalf("status %d, want %d", rec.Code, tt.code)
+			}
+			body := rec.Body.String()
+			for _, want := range tt.want {
+				if !strings.Contains(body, want) {
+					t.Errorf("body does not contain %q", want)
+				}
+			}
+			for _, absent := range tt.absent {
+				if strings.Contains(body, absent) {
+					t.Errorf("body contains %q", absent)
+				}
+			}
+		})
+	}
+}
+
+func TestVerifyClearsCache(t *testing.T) {
+	s := newTestServer(t)
+	get(t, s, http.MethodGet, "/day/1")
+	if len(s.verified) != 1 {
+		t.Fatalf("%d days cached, want 1", len(s.verified))
+	}
+
+	rec := get(t, s, http.MethodPost, "/verify?day=1")
+	if rec.Code != http.StatusSeeOther || rec.Header().Get("Location") != "/day/1" {
+		t.Errorf("POST /verify = %d to %q, want %d to /day/1", rec.Code, rec.Header().Get("Location"), http.StatusSeeOther)
+	}
+	if len(s.verified) != 0 {
+		t.Errorf("%d days still cached after POST /verify", len(s.verified))
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	history := []sample{
+		{Result: bench.Result{Parse: 10}},
+		{Result: bench.Result{Error: "boom"}},
+		{Result: bench.Result{Parse: 20}},
+		{Result: bench.Result{Parse: 5}},
+	}
+	if got, want := sparkline(history), "0.0,10.0 50.0,0.0 100.0,15.0"; got != want {
+		t.Errorf("sparkline() = %q, want %q", got, want)
+	}
+	if got := sparkline(history[:1]); got != "" {
+		t.Errorf("sparkline() of one sample = %q, want none", got)
+	}
+}
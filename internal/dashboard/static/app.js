@@ -0,0 +1,56 @@
+// Draws every visual grid on a canvas, one block per cell. The grid stays
+// in the page as text, so it is still readable if this script fails.
+(function () {
+	"use strict";
+
+	var palette = {
+		".": "#1a1a33",
+		"#": "#555566",
+		"O": "#ffff66",
+		"X": "#ffff66",
+		"[": "#c08040",
+		"]": "#c08040",
+		"@": "#ff4444",
+		"^": "#ff4444",
+		">": "#ff4444",
+		"v": "#ff4444",
+		"<": "#ff4444",
+		"S": "#00cc00",
+		"E": "#00cc00"
+	};
+
+	// colour picks a colour for a cell, spreading runes without a fixed
+	// colour, such as plant types, around the hue circle.
+	function colour(ch) {
+		if (palette[ch]) {
+			return palette[ch];
+		}
+		var hue = (ch.charCodeAt(0) * 137) % 360;
+		return "hsl(" + hue + ", 60%, 50%)";
+	}
+
+	function draw(pre) {
+		var rows = pre.textContent.replace(/\n+$/, "").split("\n");
+		var height = rows.length;
+		var width = Math.max.apply(null, rows.map(function (row) { return row.length; }));
+		var scale = Math.max(2, Math.min(16, Math.floor(800 / Math.max(width, height))));
+
+		var canvas = document.createElement("canvas");
+		canvas.className = "visual";
+		canvas.width = width * scale;
+		canvas.height = height * scale;
+		var ctx = canvas.getContext("2d");
+
+		rows.forEach(function (row, y) {
+			for (var x = 0; x < row.length; x++) {
+				ctx.fillStyle = colour(row[x]);
+				ctx.fillRect(x * scale, y * scale, scale, scale);
+			}
+		});
+
+		pre.hidden = true;
+		pre.parentNode.insertBefore(canvas, pre);
+	}
+
+	document.querySelectorAll("pre.visual").forEach(draw);
+})();
@@ -0,0 +1,95 @@
+// Package inputs resolves where a day's puzzle input comes from: a file,
+// standard input, or the example embedded in the day's solver.
+package inputs
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/reckerp/aoc-2024/internal/params"
+	"github.com/reckerp/aoc-2024/registry"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+// Stdin is the path that selects standard input.
+const Stdin = "-"
+
+// Loader reads puzzle inputs and caches them by name, so standard input is
+// consumed only once and parts that share an input can share its model.
+type Loader struct {
+	// Path is the input file, Stdin for standard input, or empty for the
+	// day's default input file.
+	Path string
+	// Example selects the example embedded in the day's solver instead of
+	// reading Path.
+	Example bool
+	// Stdin is read when Path is Stdin. It defaults to os.Stdin.
+	Stdin io.Reader
+	// Data, when not nil, is the input itself, such as a generated one,
+	// and Path only names it.
+	Data []byte
+	// Config overrides the params of the real puzzle, and Overrides those
+	// of whichever input is read.
+	Config    params.Config
+	Overrides solver.Params
+
+	cache map[string][]byte
+}
+
+// Load returns a name identifying the input for one part of a day of a
+// year, along with its contents. Parts that resolve to the same name share
+// an input.
+func (l *Loader) Load(year, day, part int, s solver.Solver) (string, []byte, error) {
+	if l.Example {
+		data, ok := s.Example(part)
+		if !ok {
+			return "", nil, fmt.Errorf("day %d has no embedded example for part %d", day, part)
+		}
+		name := "example"
+		if other, _ := s.Example(3 - part); !bytes.Equal(data, other) {
+			name = fmt.Sprintf("example (part %d)", part)
+		}
+		return name, data, nil
+	}
+
+	name := l.Path
+	if l.Data != nil {
+		return name, l.Data, nil
+	}
+	if name == "" {
+		name = registry.InputPath(year, day)
+	}
+	if data, ok := l.cache[name]; ok {
+		return name, data, nil
+	}
+
+	data, err := l.read(name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if l.cache == nil {
+		l.cache = make(map[string][]byte)
+	}
+	l.cache[name] = data
+	return name, data, nil
+}
+
+// Params returns the params to solve a day's input with.
+func (l *Loader) Params(year, day int, s solver.Solver) solver.Params {
+	return l.Config.For(s, year, day, l.Example, l.Overrides)
+}
+
+func (l *Loader) read(name string) ([]byte, error) {
+	if name != Stdin {
+		return os.ReadFile(name)
+	}
+
+	stdin := l.Stdin
+	if stdin == nil {
+		stdin = os.Stdin
+	}
+	return io.ReadAll(stdin)
+}
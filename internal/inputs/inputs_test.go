@@ -0,0 +1,71 @@
+package inputs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/d01"
+	"github.com/reckerp/aoc-2024/d03"
+)
+
+func TestLoadStdinOnce(t *testing.T) {
+	loader := &Loader{Path: Stdin, Stdin: strings.NewReader("3   4\n")}
+
+	for part := 1; part <= 2; part++ {
+		name, data, err := loader.Load(2024, 1, part, d01.Solver)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if name != Stdin || string(data) != "3   4\n" {
+			t.Errorf("part %d: got %q %q, want stdin contents", part, name, data)
+		}
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(path, []byte("1   2\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	loader := &Loader{Path: path}
+	name, data, err := loader.Load(2024, 1, 1, d01.Solver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != path || string(data) != "1   2\n" {
+		t.Errorf("got %q %q", name, data)
+	}
+}
+
+func TestLoadData(t *testing.T) {
+	loader := &Loader{Path: "generated", Data: []byte("5   6\n")}
+	name, data, err := loader.Load(2024, 1, 2, d01.Solver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "generated" || string(data) != "5   6\n" {
+		t.Errorf("got %q %q", name, data)
+	}
+}
+
+func TestLoadExample(t *testing.T) {
+	loader := &Loader{Example: true}
+
+	shared1, _, err := loader.Load(2024, 1, 1, d01.Solver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shared2, _, _ := loader.Load(2024, 1, 2, d01.Solver)
+	if shared1 != shared2 {
+		t.Errorf("day 1 parts should share an example, got %q and %q", shared1, shared2)
+	}
+
+	split1, data1, _ := loader.Load(2024, 3, 1, d03.Solver)
+	split2, data2, _ := loader.Load(2024, 3, 2, d03.Solver)
+	if split1 == split2 || string(data1) == string(data2) {
+		t.Errorf("day 3 parts should use separate examples, got %q and %q", split1, split2)
+	}
+}
@@ -0,0 +1,70 @@
+// Package params resolves the params each day is solved with: the day's
+// defaults for the real puzzle or its examples, then the params file, then
+// overrides given on the command line.
+package params
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+// File is the default params file, relative to the repository root.
+const File = "params.json"
+
+// Config maps year -> day -> param -> value, overriding the defaults of the
+// real puzzle. The examples always use their own defaults.
+type Config map[int]map[int]solver.Params
+
+// Load reads the params file at path. A missing file yields an empty set.
+func Load(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Config{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := Config{}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid params file %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// For returns the params to solve a day of a year with: the defaults for
+// its real puzzle with the config applied or, if example is set, the
+// defaults for its examples, followed in both cases by the overrides.
+func (c Config) For(s solver.Solver, year, day int, example bool, overrides solver.Params) solver.Params {
+	p := s.Params(example)
+	if !example {
+		p = p.With(c[year][day])
+	}
+	return p.With(overrides)
+}
+
+// Flag collects name=value overrides from a repeatable command-line flag.
+type Flag solver.Params
+
+func (f Flag) String() string {
+	return solver.Params(f).String()
+}
+
+func (f Flag) Set(value string) error {
+	name, v, ok := strings.Cut(value, "=")
+	if !ok || name == "" {
+		return fmt.Errorf("want name=value, got %q", value)
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return fmt.Errorf("param %s: want an integer, got %q", name, v)
+	}
+	f[name] = n
+	return nil
+}
@@ -0,0 +1,107 @@
+package params
+
+import (
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+func testSolver() solver.Solver {
+	return solver.NewWithParams(
+		func(r io.Reader) (string, error) { return "", nil },
+		func(_ string, p solver.Params) (int, error) { return p["width"], nil },
+		func(_ string, p solver.Params) (int, error) { return p["height"], nil },
+		solver.Params{"width": 101, "height": 103},
+		solver.WithExampleParams([]byte(`{"width": 11, "height": 7}`)),
+	)
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), File)
+	cfg, err := Load(path)
+	if err != nil || len(cfg) != 0 {
+		t.Fatalf("Load(missing) = %v, %v; want an empty config", cfg, err)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"2024": {"14": {"width": 5}}, "2025": {"14": {"width": 6}}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg[2024][14]["width"]; got != 5 {
+		t.Errorf("cfg[2024][14][width] = %d, want 5", got)
+	}
+	if got := cfg[2025][14]["width"]; got != 6 {
+		t.Errorf("cfg[2025][14][width] = %d, want 6", got)
+	}
+
+	for _, bad := range []string{`{"2024": {"14": {"width": "wide"}}}`, `{"2024": {"width": 5}}`} {
+		if err := os.WriteFile(path, []byte(bad), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Load(path); err == nil {
+			t.Errorf("Load accepted %s", bad)
+		}
+	}
+}
+
+func TestFor(t *testing.T) {
+	s := testSolver()
+	cfg := Config{2024: {14: {"width": 50}}}
+
+	tests := []struct {
+		example   bool
+		overrides solver.Params
+		want      string
+	}{
+		{false, nil, "height=103 width=50"},
+		{false, solver.Params{"height": 9}, "height=9 width=50"},
+		{true, nil, "height=7 width=11"},
+		{true, solver.Params{"width": 3}, "height=7 width=3"},
+	}
+	for _, tt := range tests {
+		if got := cfg.For(s, 2024, 14, tt.example, tt.overrides).String(); got != tt.want {
+			t.Errorf("For(example=%v, %v) = %s, want %s", tt.example, tt.overrides, got, tt.want)
+		}
+	}
+	if got := cfg.For(s, 2025, 14, false, nil).String(); got != "height=103 width=101" {
+		t.Errorf("For(2025) = %s, want the defaults", got)
+	}
+}
+
+func TestFlag(t *testing.T) {
+	overrides := Flag{}
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(overrides, "param", "")
+
+	if err := fs.Parse([]string{"--param", "width=11", "--param", "height=7"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := overrides.String(); got != "height=7 width=11" {
+		t.Errorf("overrides = %s, want height=7 width=11", got)
+	}
+
+	for _, bad := range []string{"width", "=3", "width=wide"} {
+		if err := fs.Parse([]string{"--param", bad}); err == nil || !strings.Contains(err.Error(), "param") {
+			t.Errorf("--param %s: error = %v, want one", bad, err)
+		}
+	}
+}
+
+func TestSolveRejectsUnknownParams(t *testing.T) {
+	s := testSolver()
+	if _, err := s.Solve(1, "", solver.Params{"widht": 3}); err == nil || !strings.Contains(err.Error(), `unknown param "widht"`) {
+		t.Errorf("Solve with a misspelt param: error = %v", err)
+	}
+	if got, err := s.Solve(1, "", solver.Params{"width": 3}); err != nil || got != "3" {
+		t.Errorf("Solve(width=3) = %q, %v; want 3", got, err)
+	}
+}
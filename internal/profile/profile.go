@@ -0,0 +1,127 @@
+// Package profile records CPU profiles, allocation profiles and execution
+// traces of each part of a day as it is solved, so hot spots can be found
+// with go tool pprof and go tool trace without writing a harness.
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"strings"
+)
+
+// Profiler writes the requested profiles of every part it wraps. Each path
+// names the profile of all parts; PartPath derives the file of one part.
+type Profiler struct {
+	CPU   string
+	Mem   string
+	Trace string
+
+	// Written lists every file written so far.
+	Written []string
+
+	errs []error
+}
+
+// Enabled reports whether any profile was requested.
+func (p *Profiler) Enabled() bool {
+	return p.CPU != "" || p.Mem != "" || p.Trace != ""
+}
+
+// Err returns the errors met while writing profiles, if any.
+func (p *Profiler) Err() error {
+	return errors.Join(p.errs...)
+}
+
+// PartPath inserts the day and part before the extension of path, so that
+// cpu.out becomes cpu.day11.part2.out.
+func PartPath(path string, day, part int) string {
+	ext := filepath.Ext(path)
+	return fmt.Sprintf("%s.day%02d.part%d%s", strings.TrimSuffix(path, ext), day, part, ext)
+}
+
+// Part runs solve with the requested profiles recording. Its signature
+// matches runner.Hook.
+//
+// Allocation profiles count every allocation since the program started, so
+// the profile written before the part, with ".base" added to its name, is
+// meant to be passed as -diff_base to go tool pprof to isolate the part.
+func (p *Profiler) Part(day, part int, solve func()) {
+	var stops []func() error
+	if p.CPU != "" {
+		if f := p.create(PartPath(p.CPU, day, part)); f != nil {
+			if err := pprof.StartCPUProfile(f); err != nil {
+				p.fail(f, err)
+			} else {
+				stops = append(stops, func() error {
+					pprof.StopCPUProfile()
+					return f.Close()
+				})
+			}
+		}
+	}
+	if p.Trace != "" {
+		if f := p.create(PartPath(p.Trace, day, part)); f != nil {
+			if err := trace.Start(f); err != nil {
+				p.fail(f, err)
+			} else {
+				stops = append(stops, func() error {
+					trace.Stop()
+					return f.Close()
+				})
+			}
+		}
+	}
+
+	// The allocation profiles are taken inside the others so that the
+	// difference between them leaves out starting and stopping those.
+	if p.Mem != "" {
+		p.writeHeap(PartPath(p.Mem, day, part) + ".base")
+	}
+	solve()
+	if p.Mem != "" {
+		p.writeHeap(PartPath(p.Mem, day, part))
+	}
+
+	for _, stop := range stops {
+		if err := stop(); err != nil {
+			p.errs = append(p.errs, err)
+		}
+	}
+}
+
+// writeHeap writes the allocation profile, after a collection so that it
+// is up to date.
+func (p *Profiler) writeHeap(path string) {
+	f := p.create(path)
+	if f == nil {
+		return
+	}
+	runtime.GC()
+	if err := pprof.Lookup("allocs").WriteTo(f, 0); err != nil {
+		p.fail(f, err)
+		return
+	}
+	if err := f.Close(); err != nil {
+		p.errs = append(p.errs, err)
+	}
+}
+
+func (p *Profiler) create(path string) *os.File {
+	f, err := os.Create(path)
+	if err != nil {
+		p.errs = append(p.errs, err)
+		return nil
+	}
+	p.Written = append(p.Written, path)
+	return f
+}
+
+func (p *Profiler) fail(f *os.File, err error) {
+	f.Close()
+	p.errs = append(p.errs, fmt.Errorf("%s: %w", f.Name(), err))
+}
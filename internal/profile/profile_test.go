@@ -0,0 +1,84 @@
+package profile
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestPartPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"cpu.out", "cpu.day11.part2.out"},
+		{"prof/mem.pprof", "prof/mem.day11.part2.pprof"},
+		{"trace", "trace.day11.part2"},
+	}
+	for _, tt := range tests {
+		if got := PartPath(tt.path, 11, 2); got != tt.want {
+			t.Errorf("PartPath(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestPart(t *testing.T) {
+	dir := t.TempDir()
+	p := &Profiler{
+		CPU:   filepath.Join(dir, "cpu.out"),
+		Mem:   filepath.Join(dir, "mem.out"),
+		Trace: filepath.Join(dir, "trace.out"),
+	}
+	if !p.Enabled() {
+		t.Fatal("Enabled() = false with every profile requested")
+	}
+
+	for part := 1; part <= 2; part++ {
+		solved := false
+		p.Part(7, part, func() {
+			keys := make([]string, 0, 1000)
+			for i := range 1000 {
+				keys = append(keys, strings.Repeat("x", i%10))
+			}
+			solved = len(keys) == 1000
+		})
+		if !solved {
+			t.Fatalf("part %d was not solved", part)
+		}
+	}
+	if err := p.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	var want []string
+	for part := 1; part <= 2; part++ {
+		for _, name := range []string{"cpu.day07.part%d.out", "mem.day07.part%d.out", "mem.day07.part%d.out.base", "trace.day07.part%d.out"} {
+			want = append(want, filepath.Join(dir, fmt.Sprintf(name, part)))
+		}
+	}
+	got := slices.Sorted(slices.Values(p.Written))
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Fatalf("Written = %q, want %q", got, want)
+	}
+	for _, path := range got {
+		if info, err := os.Stat(path); err != nil || info.Size() == 0 {
+			t.Errorf("%s: not written (%v)", path, err)
+		}
+	}
+}
+
+func TestPartReportsErrors(t *testing.T) {
+	p := &Profiler{CPU: filepath.Join(t.TempDir(), "missing", "cpu.out")}
+	solved := false
+	p.Part(1, 1, func() { solved = true })
+	if !solved {
+		t.Error("solve was not called when the profile could not be created")
+	}
+	if p.Err() == nil {
+		t.Error("Err() = nil after failing to create the profile")
+	}
+}
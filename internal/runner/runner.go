@@ -0,0 +1,240 @@
+// Package runner solves days end to end: it loads and parses their input,
+// solves the requested parts, times every phase and turns failures,
+// including panics, into per-part errors.
+package runner
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/reckerp/aoc-2024/internal/bench"
+	"github.com/reckerp/aoc-2024/internal/inputs"
+	"github.com/reckerp/aoc-2024/parse"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+// Part is the outcome of solving one part of a day.
+type Part struct {
+	Part   int
+	Answer string
+	// Parse is the time spent parsing the part's input. Parts that share
+	// an input share one parse, which is only counted for the first.
+	Parse time.Duration
+	Solve time.Duration
+	Err   error
+}
+
+// Day is the outcome of solving the requested parts of one day.
+type Day struct {
+	Year  int
+	Day   int
+	Parts []Part
+}
+
+// Failed reports whether any part of the day failed.
+func (d Day) Failed() bool {
+	for _, p := range d.Parts {
+		if p.Err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Hook wraps the solve phase of one part, for example to profile it. It
+// must call solve exactly once. The part's timing covers only solve, not
+// the work the hook does around it.
+type Hook func(day, part int, solve func())
+
+// Solve runs the given parts of a day of a year against the inputs provided
+// by loader. A loader is not safe for concurrent use, so each day needs its
+// own. Hooks wrap each part's solve phase, the first outermost.
+func Solve(year, day int, s solver.Solver, parts []int, loader *inputs.Loader, hooks ...Hook) Day {
+	type model struct {
+		input any
+		err   error
+	}
+	parsed := make(map[string]model)
+	params := loader.Params(year, day, s)
+
+	result := Day{Year: year, Day: day}
+	for _, p := range parts {
+		part := Part{Part: p}
+		result.Parts = append(result.Parts, part)
+		res := &result.Parts[len(result.Parts)-1]
+
+		name, data, err := loader.Load(year, day, p, s)
+		if err != nil {
+			res.Err = err
+			continue
+		}
+
+		m, ok := parsed[name]
+		if !ok {
+			start := time.Now()
+			err := protect(func() (err error) {
+				m.input, err = s.Parse(bytes.NewReader(data))
+				return err
+			})
+			res.Parse = time.Since(start)
+			if err != nil {
+				m.err = parseError(err, name)
+			}
+			parsed[name] = m
+		}
+		if m.err != nil {
+			res.Err = m.err
+			continue
+		}
+
+		run := func() {
+			start := time.Now()
+			res.Err = protect(func() (err error) {
+				res.Answer, err = s.Solve(p, m.input, params)
+				return err
+			})
+			res.Solve = time.Since(start)
+		}
+		for i := len(hooks) - 1; i >= 0; i-- {
+			hook, next := hooks[i], run
+			run = func() { hook(day, p, next) }
+		}
+		run()
+	}
+	return result
+}
+
+// protect runs f, turning a panic into an error so one broken day cannot
+// take down the others.
+func protect(f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return f()
+}
+
+// parseError attributes a parse error to the named input. Positioned errors
+// carry the name in place of their file, anything else is prefixed by it.
+func parseError(err error, name string) error {
+	if name == inputs.Stdin {
+		name = "<stdin>"
+	}
+	var pe *parse.Error
+	if !errors.As(err, &pe) {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return parse.InFile(err, name)
+}
+
+// All solves every day with at most jobs days running at once; jobs below
+// one means one per CPU. Results are returned in the order of days no
+// matter which finishes first.
+func All(days []int, jobs int, solve func(day int) Day) []Day {
+	if jobs < 1 {
+		jobs = runtime.NumCPU()
+	}
+
+	results := make([]Day, len(days))
+	work := make(chan int)
+	var wg sync.WaitGroup
+	for range min(jobs, len(days)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				results[i] = solve(days[i])
+			}
+		}()
+	}
+	for i := range days {
+		work <- i
+	}
+	close(work)
+	wg.Wait()
+
+	return results
+}
+
+// WriteSummary prints one row per part in day order, followed by a count of
+// failures and the total time spent.
+func WriteSummary(w io.Writer, days []Day) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "DAY\tPART\tANSWER\tTIME")
+
+	var parts, failed int
+	var total time.Duration
+	for _, d := range days {
+		for _, p := range d.Parts {
+			parts++
+			elapsed := p.Parse + p.Solve
+			total += elapsed
+
+			answer := p.Answer
+			if p.Err != nil {
+				failed++
+				answer = "error: " + p.Err.Error()
+			}
+			fmt.Fprintf(tw, "%d\t%d\t%s\t%s\n", d.Day, p.Part, answer, bench.Round(elapsed))
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d days, %d parts, %d failed, %s total\n", len(days), parts, failed, bench.Round(total))
+	return err
+}
+
+// Record is the machine-readable form of one part's outcome.
+type Record struct {
+	Year   int    `json:"year"`
+	Day    int    `json:"day"`
+	Part   int    `json:"part"`
+	Answer string `json:"answer"`
+	// Duration covers parsing and solving, in nanoseconds.
+	Duration time.Duration `json:"duration_ns"`
+	Error    string        `json:"error"`
+}
+
+// Records flattens days into one record per part, in order.
+func Records(days []Day) []Record {
+	records := []Record{}
+	for _, d := range days {
+		for _, p := range d.Parts {
+			r := Record{Year: d.Year, Day: d.Day, Part: p.Part, Answer: p.Answer, Duration: p.Parse + p.Solve}
+			if p.Err != nil {
+				r.Error = p.Err.Error()
+			}
+			records = append(records, r)
+		}
+	}
+	return records
+}
+
+// WriteJSON writes the records of days as one indented JSON array.
+func WriteJSON(w io.Writer, days []Day) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(Records(days))
+}
+
+// WriteNDJSON writes the records of days as newline-delimited JSON, one
+// record per line.
+func WriteNDJSON(w io.Writer, days []Day) error {
+	enc := json.NewEncoder(w)
+	for _, r := range Records(days) {
+		if err := enc.Encode(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
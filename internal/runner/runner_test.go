@@ -0,0 +1,149 @@
+package runner
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reckerp/aoc-2024/internal/inputs"
+	"github.com/reckerp/aoc-2024/parse"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+func testSolver(parses *int) solver.Solver {
+	return solver.New(
+		func(r io.Reader) (string, error) {
+			*parses++
+			data, err := io.ReadAll(r)
+			if bytes.HasPrefix(data, []byte("bad")) {
+				return "", &parse.Error{Line: 1, Col: 1, Expected: "good input", Found: "bad"}
+			}
+			return strings.TrimSpace(string(data)), err
+		},
+		func(in string) (int, error) { return len(in), nil },
+		func(in string) (int, error) {
+			if in == "boom" {
+				panic("robot not found in the grid")
+			}
+			return 0, errors.New("unsolved")
+		},
+		solver.WithExample([]byte("boom\n")),
+	)
+}
+
+func TestSolve(t *testing.T) {
+	var parses int
+	result := Solve(2024, 3, testSolver(&parses), []int{1, 2}, &inputs.Loader{Example: true})
+
+	if parses != 1 {
+		t.Errorf("parsed %d times, want once for a shared input", parses)
+	}
+	if p := result.Parts[0]; p.Err != nil || p.Answer != "4" {
+		t.Errorf("part 1 = %q, %v, want 4", p.Answer, p.Err)
+	}
+	if p := result.Parts[1]; p.Err == nil || p.Err.Error() != "panic: robot not found in the grid" {
+		t.Errorf("part 2 error = %v, want the recovered panic", p.Err)
+	}
+	if !result.Failed() {
+		t.Error("Failed() = false with a panicking part")
+	}
+}
+
+func TestSolveHooks(t *testing.T) {
+	var calls []string
+	hook := func(name string) Hook {
+		return func(day, part int, solve func()) {
+			calls = append(calls, fmt.Sprintf("%s day %d part %d", name, day, part))
+			solve()
+			calls = append(calls, name+" done")
+		}
+	}
+
+	var parses int
+	result := Solve(2024, 3, testSolver(&parses), []int{1}, &inputs.Loader{Example: true}, hook("outer"), hook("inner"))
+	if p := result.Parts[0]; p.Err != nil || p.Answer != "4" {
+		t.Errorf("part 1 = %q, %v, want 4", p.Answer, p.Err)
+	}
+	want := []string{"outer day 3 part 1", "inner day 3 part 1", "inner done", "outer done"}
+	if !slices.Equal(calls, want) {
+		t.Errorf("hook calls = %q, want %q", calls, want)
+	}
+}
+
+func TestSolveParseError(t *testing.T) {
+	var parses int
+	loader := &inputs.Loader{Path: inputs.Stdin, Stdin: strings.NewReader("bad input")}
+	result := Solve(2024, 3, testSolver(&parses), []int{1, 2}, loader)
+
+	for _, p := range result.Parts {
+		if p.Err == nil || p.Err.Error() != `<stdin>:1:1: expected good input, found "bad"` {
+			t.Errorf("part %d error = %v", p.Part, p.Err)
+		}
+	}
+	if parses != 1 {
+		t.Errorf("parsed %d times, want the failed parse to be reused", parses)
+	}
+}
+
+func TestAllKeepsDayOrder(t *testing.T) {
+	days := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	results := All(days, 3, func(day int) Day {
+		// Later days finish first.
+		time.Sleep(time.Duration(len(days)-day) * time.Millisecond)
+		return Day{Day: day}
+	})
+
+	for i, r := range results {
+		if r.Day != days[i] {
+			t.Fatalf("results[%d] is day %d, want %d", i, r.Day, days[i])
+		}
+	}
+}
+
+func TestWriteSummary(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteSummary(&buf, []Day{
+		{Day: 1, Parts: []Part{{Part: 1, Answer: "11"}, {Part: 2, Err: errors.New("unsolved")}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"DAY  PART  ANSWER", "1    2     error: unsolved", "1 days, 2 parts, 1 failed"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("summary missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	days := []Day{
+		{Year: 2024, Day: 1, Parts: []Part{{Part: 1, Answer: "11", Parse: 2, Solve: 3}}},
+		{Year: 2024, Day: 2, Parts: []Part{{Part: 2, Err: errors.New("unsolved")}}},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteNDJSON(&buf, days); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"year":2024,"day":1,"part":1,"answer":"11","duration_ns":5,"error":""}
+{"year":2024,"day":2,"part":2,"answer":"","duration_ns":0,"error":"unsolved"}
+`
+	if buf.String() != want {
+		t.Errorf("WriteNDJSON() =\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	buf.Reset()
+	if err := WriteJSON(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "[]\n" {
+		t.Errorf("WriteJSON(nil) = %q, want an empty array", buf.String())
+	}
+}
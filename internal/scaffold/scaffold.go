@@ -0,0 +1,261 @@
+// Package scaffold generates the boilerplate for a new day: the solver
+// package with its test, benchmark and example fixture, the registry entry
+// and placeholder answers. Days of registry.HomeYear go in the repository
+// root, those of other years in a directory named after the year.
+package scaffold
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"text/template"
+
+	"github.com/reckerp/aoc-2024/internal/answers"
+	"github.com/reckerp/aoc-2024/registry"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var templates = template.Must(template.ParseFS(templateFS, "templates/*.tmpl"))
+
+// RegistryFile is the generated file, relative to the repository root, that
+// lists every day's solver.
+const RegistryFile = "registry/days.go"
+
+var (
+	moduleRe  = regexp.MustCompile(`(?m)^module\s+(\S+)`)
+	dayDirRe  = regexp.MustCompile(`^d(\d\d)$`)
+	yearDirRe = regexp.MustCompile(`^\d{4}$`)
+)
+
+// firstYear is the year of the first Advent of Code.
+const firstYear = 2015
+
+type day struct {
+	Year    int
+	Day     int
+	Package string
+	Module  string
+	// Path is the package's import path relative to the module, and Alias
+	// the name it is imported as where Package would clash with another
+	// year's day.
+	Path  string
+	Alias string
+}
+
+// Name returns the name the registry refers to the package by.
+func (d day) Name() string {
+	if d.Alias != "" {
+		return d.Alias
+	}
+	return d.Package
+}
+
+func newDay(year, dayNum int, module string) day {
+	d := day{
+		Year:    year,
+		Day:     dayNum,
+		Package: fmt.Sprintf("d%02d", dayNum),
+		Module:  module,
+		Path:    filepath.ToSlash(registry.Dir(year, dayNum)),
+	}
+	if year != registry.HomeYear {
+		d.Alias = fmt.Sprintf("y%d%s", year, d.Package)
+	}
+	return d
+}
+
+// New creates the package for a day of year under root, regenerates the
+// registry and adds placeholder entries to the answers file at answersPath.
+// It refuses to touch a day that already exists and returns the paths it
+// wrote.
+func New(root string, year, dayNum int, answersPath string) ([]string, error) {
+	if year < firstYear || year > 9999 {
+		return nil, fmt.Errorf("invalid year: %d", year)
+	}
+	if dayNum < 1 || dayNum > 25 {
+		return nil, fmt.Errorf("invalid day: %d", dayNum)
+	}
+
+	module, err := modulePath(root)
+	if err != nil {
+		return nil, err
+	}
+	d := newDay(year, dayNum, module)
+
+	dir := filepath.Join(root, registry.Dir(year, dayNum))
+	if _, err := os.Stat(dir); err == nil {
+		return nil, fmt.Errorf("%s already exists", dir)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	files := []struct {
+		path     string
+		template string
+	}{
+		{filepath.Join(dir, d.Package+".go"), "day.go.tmpl"},
+		{filepath.Join(dir, d.Package+"_test.go"), "day_test.go.tmpl"},
+		{filepath.Join(dir, "testdata", "example.txt"), ""},
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, "testdata"), 0o755); err != nil {
+		return nil, err
+	}
+	var written []string
+	for _, f := range files {
+		var src []byte
+		if f.template != "" {
+			if src, err = render(f.template, d); err != nil {
+				return written, err
+			}
+		}
+		if err := writeNew(f.path, src); err != nil {
+			return written, err
+		}
+		written = append(written, f.path)
+	}
+
+	registryPath, err := WriteRegistry(root)
+	if err != nil {
+		return written, err
+	}
+	written = append(written, registryPath)
+
+	ans, err := answers.Load(answersPath)
+	if err != nil {
+		return written, err
+	}
+	for part := 1; part <= 2; part++ {
+		if _, ok := ans[year][dayNum][part]; !ok {
+			ans.Set(year, dayNum, part, "")
+		}
+	}
+	if err := ans.Save(answersPath); err != nil {
+		return written, err
+	}
+	return append(written, answersPath), nil
+}
+
+type yearDays struct {
+	Year int
+	Days []day
+}
+
+// WriteRegistry regenerates RegistryFile from the day packages found under
+// root, and under the directory of each year besides the home year, and
+// returns its path.
+func WriteRegistry(root string) (string, error) {
+	module, err := modulePath(root)
+	if err != nil {
+		return "", err
+	}
+
+	home, err := findDays(root, registry.HomeYear, module)
+	if err != nil {
+		return "", err
+	}
+	var years []yearDays
+	if len(home) > 0 {
+		years = append(years, yearDays{registry.HomeYear, home})
+	}
+
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return "", err
+	}
+	for _, e := range entries {
+		if !yearDirRe.MatchString(e.Name()) || !e.IsDir() {
+			continue
+		}
+		n, _ := strconv.Atoi(e.Name())
+		if n == registry.HomeYear {
+			continue
+		}
+		days, err := findDays(filepath.Join(root, e.Name()), n, module)
+		if err != nil {
+			return "", err
+		}
+		if len(days) > 0 {
+			years = append(years, yearDays{n, days})
+		}
+	}
+	slices.SortFunc(years, func(a, b yearDays) int { return a.Year - b.Year })
+
+	src, err := render("days.go.tmpl", struct {
+		Module string
+		Years  []yearDays
+	}{module, years})
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(root, RegistryFile)
+	return path, os.WriteFile(path, src, 0o644)
+}
+
+// findDays lists the day packages of a year found in dir.
+func findDays(dir string, yearNum int, module string) ([]day, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var days []day
+	for _, e := range entries {
+		m := dayDirRe.FindStringSubmatch(e.Name())
+		if m == nil || !e.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(dir, e.Name(), e.Name()+".go")); err != nil {
+			continue
+		}
+		n, _ := strconv.Atoi(m[1])
+		days = append(days, newDay(yearNum, n, module))
+	}
+	return days, nil
+}
+
+func render(name string, data any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, name, data); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return src, nil
+}
+
+// writeNew creates path with data, failing if the file already exists.
+func writeNew(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func modulePath(root string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return "", err
+	}
+	m := moduleRe.FindSubmatch(data)
+	if m == nil {
+		return "", fmt.Errorf("%s: no module directive", filepath.Join(root, "go.mod"))
+	}
+	return string(m[1]), nil
+}
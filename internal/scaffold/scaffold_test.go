@@ -0,0 +1,131 @@
+package scaffold
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/internal/answers"
+)
+
+func testRoot(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	for path, content := range map[string]string{
+		"go.mod":             "module example.com/aoc\n\ngo 1.23.3\n",
+		"d01/d01.go":         "package d01\n",
+		"registry/days.go":   "",
+		"notaday/notaday.go": "package notaday\n",
+	} {
+		path = filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func TestNew(t *testing.T) {
+	root := testRoot(t)
+	answersPath := filepath.Join(root, "answers.json")
+
+	written, err := New(root, 2024, 19, answersPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(written) != 5 {
+		t.Errorf("wrote %v, want 5 files", written)
+	}
+
+	src, err := os.ReadFile(filepath.Join(root, "d19", "d19.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"package d19", `"example.com/aoc/solver"`, "var Solver = solver.New(Parse, Part1, Part2"} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("d19.go missing %q", want)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(root, "d19", "testdata", "example.txt")); err != nil {
+		t.Error(err)
+	}
+	test, err := os.ReadFile(filepath.Join(root, "d19", "d19_test.go"))
+	if err != nil || !strings.Contains(string(test), "func BenchmarkPart2(") {
+		t.Errorf("d19_test.go has no benchmark: %v", err)
+	}
+
+	registry, err := os.ReadFile(filepath.Join(root, RegistryFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"example.com/aoc/d01"`, `"example.com/aoc/d19"`, "2024: {", "19: d19.Solver,"} {
+		if !strings.Contains(string(registry), want) {
+			t.Errorf("registry missing %q:\n%s", want, registry)
+		}
+	}
+	if strings.Contains(string(registry), "notaday") {
+		t.Error("registry includes a directory that is not a day")
+	}
+
+	ans, err := answers.Load(answersPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if answer, ok := ans[2024][19][2]; !ok || answer != "" {
+		t.Errorf("answers[2024][19][2] = %q, %v, want an empty placeholder", answer, ok)
+	}
+}
+
+func TestNewOtherYear(t *testing.T) {
+	root := testRoot(t)
+	answersPath := filepath.Join(root, "answers.json")
+
+	if _, err := New(root, 2025, 1, answersPath); err != nil {
+		t.Fatal(err)
+	}
+	src, err := os.ReadFile(filepath.Join(root, "2025", "d01", "d01.go"))
+	if err != nil || !strings.Contains(string(src), "package d01") {
+		t.Errorf("2025/d01/d01.go = %q, %v", src, err)
+	}
+
+	registry, err := os.ReadFile(filepath.Join(root, RegistryFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"example.com/aoc/d01"`, `y2025d01 "example.com/aoc/2025/d01"`, "1: d01.Solver,", "2025: {", "1: y2025d01.Solver,"} {
+		if !strings.Contains(string(registry), want) {
+			t.Errorf("registry missing %q:\n%s", want, registry)
+		}
+	}
+
+	ans, err := answers.Load(answersPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := ans[2025][1][1]; !ok {
+		t.Error("no placeholder answer for 2025 day 1")
+	}
+	if _, ok := ans[2024][1][1]; ok {
+		t.Error("placeholder answer added for 2024 day 1")
+	}
+}
+
+func TestNewRefusesToOverwrite(t *testing.T) {
+	root := testRoot(t)
+	if _, err := New(root, 2024, 1, filepath.Join(root, "answers.json")); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("New(1) error = %v, want already exists", err)
+	}
+	if src, _ := os.ReadFile(filepath.Join(root, "d01", "d01.go")); string(src) != "package d01\n" {
+		t.Error("existing day was modified")
+	}
+	if _, err := New(root, 2024, 26, filepath.Join(root, "answers.json")); err == nil {
+		t.Error("New(26) succeeded")
+	}
+	if _, err := New(root, 2014, 1, filepath.Join(root, "answers.json")); err == nil {
+		t.Error("New(2014, 1) succeeded")
+	}
+}
@@ -0,0 +1,216 @@
+// Package site talks to the Advent of Code website on behalf of a logged-in
+// user: it downloads puzzle inputs into a per-user cache and submits
+// answers.
+package site
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultBaseURL is the address of the Advent of Code website.
+	DefaultBaseURL = "https://adventofcode.com"
+
+	// SessionEnv names the environment variable holding the session token.
+	SessionEnv = "AOC_SESSION"
+	// BaseURLEnv names the environment variable that overrides the base URL.
+	BaseURLEnv = "AOC_BASE_URL"
+
+	userAgent = "github.com/reckerp/aoc-2024"
+)
+
+// ErrNoSession is returned when no session token is configured.
+var ErrNoSession = errors.New("no session token: set " + SessionEnv + " or write it to the session config file")
+
+// SessionPath returns the file the session token is read from when the
+// environment does not provide one.
+func SessionPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "aoc", "session"), nil
+}
+
+// LoadSession returns the session token from the environment or, failing
+// that, from the session config file.
+func LoadSession() (string, error) {
+	if token := strings.TrimSpace(os.Getenv(SessionEnv)); token != "" {
+		return token, nil
+	}
+
+	path, err := SessionPath()
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", ErrNoSession
+	}
+	if err != nil {
+		return "", err
+	}
+	if token := strings.TrimSpace(string(data)); token != "" {
+		return token, nil
+	}
+	return "", ErrNoSession
+}
+
+// CacheDir returns the per-user directory downloaded inputs are kept in.
+func CacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "aoc"), nil
+}
+
+// Client talks to the website on behalf of one user.
+type Client struct {
+	// BaseURL is the website address, without a trailing slash.
+	BaseURL string
+	// Session is the value of the user's session cookie.
+	Session string
+	// CacheDir holds downloaded inputs, under a directory of their own
+	// for each session as inputs differ by user. Inputs never change once
+	// published, so a cached input is never downloaded again.
+	CacheDir string
+	// HTTP is the client requests are sent with; nil means
+	// http.DefaultClient.
+	HTTP *http.Client
+	// Now returns the current time for cooldowns; nil means time.Now.
+	Now func() time.Time
+}
+
+// NewClient returns a client configured from the environment: the session
+// token from LoadSession, the base URL from BaseURLEnv and the cache
+// directory from CacheDir.
+func NewClient() (*Client, error) {
+	session, err := LoadSession()
+	if err != nil {
+		return nil, err
+	}
+	cache, err := CacheDir()
+	if err != nil {
+		return nil, err
+	}
+
+	base := os.Getenv(BaseURLEnv)
+	if base == "" {
+		base = DefaultBaseURL
+	}
+	return &Client{BaseURL: strings.TrimRight(base, "/"), Session: session, CacheDir: cache}, nil
+}
+
+// InputPath returns where the input of a day of a year's event is cached.
+func (c *Client) InputPath(year, day int) string {
+	return filepath.Join(c.userDir(year), fmt.Sprintf("day%02d.txt", day))
+}
+
+// userDir returns the cache directory of a year's event for the session's
+// user. It is named after a short hash of the token, which keeps the token
+// itself out of the file system.
+func (c *Client) userDir(year int) string {
+	sum := sha256.Sum256([]byte(c.Session))
+	return filepath.Join(c.CacheDir, hex.EncodeToString(sum[:6]), fmt.Sprint(year))
+}
+
+// Input returns the puzzle input of a day of a year's event, downloading it
+// only when it is not cached yet.
+func (c *Client) Input(ctx context.Context, year, day int) ([]byte, error) {
+	path := c.InputPath(year, day)
+	if data, err := os.ReadFile(path); err == nil {
+		return data, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	data, err := c.get(ctx, fmt.Sprintf("/%d/day/%d/input", year, day))
+	if err != nil {
+		return nil, fmt.Errorf("day %d input: %w", day, err)
+	}
+	if err := writeFileAtomic(path, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (c *Client) get(ctx context.Context, path string) ([]byte, error) {
+	req, err := c.request(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(req)
+}
+
+func (c *Client) request(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	req.Header.Set("User-Agent", userAgent)
+	return req, nil
+}
+
+func (c *Client) do(req *http.Request) ([]byte, error) {
+	client := c.HTTP
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s %s: %s: %s", req.Method, req.URL.Path, resp.Status, firstLine(body))
+	}
+	return body, nil
+}
+
+func firstLine(body []byte) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(string(body)), "\n")
+	return line
+}
+
+// writeFileAtomic writes data to path through a temporary file, so an
+// interrupted download never leaves a truncated input in the cache.
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".download-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
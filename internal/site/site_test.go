@@ -0,0 +1,159 @@
+package site
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return &Client{BaseURL: server.URL, Session: "secret", CacheDir: t.TempDir(), HTTP: server.Client()}
+}
+
+func TestInputDownloadsOnce(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/2024/day/5/input" {
+			http.NotFound(w, r)
+			return
+		}
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte("47|53\n"))
+	})
+
+	for range 2 {
+		data, err := c.Input(context.Background(), 2024, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "47|53\n" {
+			t.Fatalf("Input() = %q", data)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("made %d requests, want 1", requests)
+	}
+
+	cached, err := os.ReadFile(c.InputPath(2024, 5))
+	if err != nil || string(cached) != "47|53\n" {
+		t.Errorf("cache holds %q, %v", cached, err)
+	}
+}
+
+func TestInputYears(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.URL.Path))
+	})
+
+	for _, year := range []int{2024, 2025} {
+		data, err := c.Input(context.Background(), year, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := fmt.Sprintf("/%d/day/1/input", year)
+		if string(data) != want {
+			t.Errorf("Input(%d) = %q, want %q", year, data, want)
+		}
+		if cached, err := os.ReadFile(c.InputPath(year, 1)); err != nil || string(cached) != want {
+			t.Errorf("cache for %d holds %q, %v", year, cached, err)
+		}
+	}
+}
+
+func TestInputSessions(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		cookie, _ := r.Cookie("session")
+		w.Write([]byte(cookie.Value))
+	})
+
+	for _, session := range []string{"alice", "bob"} {
+		c.Session = session
+		if data, err := c.Input(context.Background(), 2024, 1); err != nil || string(data) != session {
+			t.Errorf("Input() for %s = %q, %v, want that session's input", session, data, err)
+		}
+	}
+
+	path := c.InputPath(2024, 1)
+	if rel, _ := filepath.Rel(c.CacheDir, path); rel != filepath.Join("81b637d8fcd2", "2024", "day01.txt") {
+		t.Errorf("InputPath() = %s, want it under the hash of the session", path)
+	}
+	if c.LogPath(2024) != filepath.Join(filepath.Dir(path), "submissions.json") {
+		t.Errorf("LogPath() = %s, want it next to the inputs of the session", c.LogPath(2024))
+	}
+}
+
+func TestInputErrors(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Please don't repeatedly request this endpoint before it unlocks!", http.StatusNotFound)
+	})
+
+	_, err := c.Input(context.Background(), 2024, 25)
+	want := "day 25 input: GET /2024/day/25/input: 404 Not Found: Please don't repeatedly request this endpoint before it unlocks!"
+	if err == nil || err.Error() != want {
+		t.Errorf("Input() error = %v, want %s", err, want)
+	}
+	if _, err := os.Stat(c.InputPath(2024, 25)); !errors.Is(err, os.ErrNotExist) {
+		t.Error("a failed download was cached")
+	}
+
+	c.Session = ""
+	if _, err := c.Input(context.Background(), 2024, 25); !errors.Is(err, ErrNoSession) {
+		t.Errorf("Input() without a session = %v, want ErrNoSession", err)
+	}
+}
+
+func TestLoadSession(t *testing.T) {
+	config := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", config)
+	t.Setenv("HOME", config)
+	t.Setenv(SessionEnv, "")
+
+	if _, err := LoadSession(); !errors.Is(err, ErrNoSession) {
+		t.Fatalf("LoadSession() with nothing configured = %v, want ErrNoSession", err)
+	}
+
+	path, err := SessionPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := LoadSession(); err != nil || got != "from-file" {
+		t.Errorf("LoadSession() = %q, %v, want from-file", got, err)
+	}
+
+	t.Setenv(SessionEnv, "from-env")
+	if got, err := LoadSession(); err != nil || got != "from-env" {
+		t.Errorf("LoadSession() = %q, %v, want from-env", got, err)
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	t.Setenv(SessionEnv, "token")
+	t.Setenv(BaseURLEnv, "http://localhost:1234/")
+	t.Setenv("XDG_CACHE_HOME", "/tmp/cache")
+
+	c, err := NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.BaseURL != "http://localhost:1234" || c.Session != "token" || c.CacheDir != "/tmp/cache/aoc" {
+		t.Errorf("NewClient() = %+v", c)
+	}
+}
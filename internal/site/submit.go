@@ -0,0 +1,223 @@
+package site
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Verdict classifies the website's reply to a submitted answer.
+type Verdict string
+
+const (
+	Correct       Verdict = "correct"
+	TooHigh       Verdict = "too high"
+	TooLow        Verdict = "too low"
+	Wrong         Verdict = "wrong"
+	AlreadySolved Verdict = "already solved"
+	RateLimited   Verdict = "rate limited"
+	Unknown       Verdict = "unknown"
+)
+
+// Response is a classified answer page.
+type Response struct {
+	Verdict Verdict
+	// Wait is how long the website asks to wait before the next answer.
+	Wait time.Duration
+	// Message is the text of the page's main article.
+	Message string
+}
+
+// wrongCooldown is assumed after a wrong answer when the page does not say
+// how long to wait.
+const wrongCooldown = time.Minute
+
+var (
+	articleRe = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagRe     = regexp.MustCompile(`<[^>]+>`)
+	leftRe    = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	minutesRe = regexp.MustCompile(`wait (one|\d+) minutes? before trying again`)
+	spacingRe = regexp.MustCompile(`\s+`)
+)
+
+// Classify reads the verdict out of an answer page.
+func Classify(page []byte) Response {
+	text := string(page)
+	if m := articleRe.FindStringSubmatch(text); m != nil {
+		text = m[1]
+	}
+	text = html.UnescapeString(tagRe.ReplaceAllString(text, ""))
+	text = strings.TrimSpace(spacingRe.ReplaceAllString(text, " "))
+
+	resp := Response{Verdict: Unknown, Message: text}
+	switch {
+	case strings.Contains(text, "That's the right answer"):
+		resp.Verdict = Correct
+	case strings.Contains(text, "answer too recently"):
+		resp.Verdict = RateLimited
+	case strings.Contains(text, "solving the right level"):
+		resp.Verdict = AlreadySolved
+	case strings.Contains(text, "your answer is too high"):
+		resp.Verdict = TooHigh
+	case strings.Contains(text, "your answer is too low"):
+		resp.Verdict = TooLow
+	case strings.Contains(text, "That's not the right answer"):
+		resp.Verdict = Wrong
+	}
+
+	if m := leftRe.FindStringSubmatch(text); m != nil {
+		minutes, _ := strconv.Atoi(m[1])
+		seconds, _ := strconv.Atoi(m[2])
+		resp.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if m := minutesRe.FindStringSubmatch(text); m != nil {
+		minutes := 1
+		if m[1] != "one" {
+			minutes, _ = strconv.Atoi(m[1])
+		}
+		resp.Wait = time.Duration(minutes) * time.Minute
+	} else if resp.Verdict.wrong() {
+		resp.Wait = wrongCooldown
+	}
+	return resp
+}
+
+func (v Verdict) wrong() bool {
+	return v == TooHigh || v == TooLow || v == Wrong
+}
+
+// Guess is one answer sent to the website.
+type Guess struct {
+	Day     int       `json:"day"`
+	Part    int       `json:"part"`
+	Answer  string    `json:"answer"`
+	Verdict Verdict   `json:"verdict"`
+	Time    time.Time `json:"time"`
+}
+
+// Log remembers a year's wrong guesses and when the next answer may be
+// sent, so the same wrong answer is never submitted twice and the website's
+// cooldown is respected before it has to say so.
+type Log struct {
+	NextAllowed time.Time `json:"next_allowed"`
+	Guesses     []Guess   `json:"guesses"`
+}
+
+// LogPath returns where the submission log of a year's event is kept.
+func (c *Client) LogPath(year int) string {
+	return filepath.Join(c.userDir(year), "submissions.json")
+}
+
+// LoadLog reads the submission log of a year's event. A missing log is
+// empty.
+func (c *Client) LoadLog(year int) (*Log, error) {
+	path := c.LogPath(year)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Log{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var log Log
+	if err := json.Unmarshal(data, &log); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &log, nil
+}
+
+func (c *Client) saveLog(year int, log *Log) error {
+	data, err := json.MarshalIndent(log, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(c.LogPath(year), append(data, '\n'))
+}
+
+// Check returns an error if answer must not be sent: the cooldown has not
+// passed, the answer was already rejected, or an earlier too high or too
+// low verdict rules it out.
+func (l *Log) Check(day, part int, answer string, now time.Time) error {
+	if wait := l.NextAllowed.Sub(now); wait > 0 {
+		return fmt.Errorf("cooling down: wait %s before submitting again", wait.Round(time.Second))
+	}
+
+	n, numeric := new(big.Int).SetString(answer, 10)
+	for _, g := range l.Guesses {
+		if g.Day != day || g.Part != part || !g.Verdict.wrong() {
+			continue
+		}
+		if g.Answer == answer {
+			return fmt.Errorf("%s was already rejected as %s", answer, g.Verdict)
+		}
+
+		bound, ok := new(big.Int).SetString(g.Answer, 10)
+		if !numeric || !ok {
+			continue
+		}
+		if g.Verdict == TooHigh && n.Cmp(bound) >= 0 {
+			return fmt.Errorf("%s is not below %s, which was too high", answer, g.Answer)
+		}
+		if g.Verdict == TooLow && n.Cmp(bound) <= 0 {
+			return fmt.Errorf("%s is not above %s, which was too low", answer, g.Answer)
+		}
+	}
+	return nil
+}
+
+// record updates the log with the outcome of a submission.
+func (l *Log) record(g Guess, resp Response) {
+	if resp.Wait > 0 {
+		l.NextAllowed = g.Time.Add(resp.Wait)
+	}
+	if resp.Verdict.wrong() {
+		l.Guesses = append(l.Guesses, g)
+	}
+}
+
+// Submit sends an answer for one part of a day of a year's event after
+// checking it against the submission log, and records the classified
+// response.
+func (c *Client) Submit(ctx context.Context, year, day, part int, answer string) (Response, error) {
+	log, err := c.LoadLog(year)
+	if err != nil {
+		return Response{}, err
+	}
+	if err := log.Check(day, part, answer, c.now()); err != nil {
+		return Response{}, err
+	}
+
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	req, err := c.request(ctx, http.MethodPost, fmt.Sprintf("/%d/day/%d/answer", year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return Response{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	page, err := c.do(req)
+	if err != nil {
+		return Response{}, fmt.Errorf("day %d part %d answer: %w", day, part, err)
+	}
+
+	resp := Classify(page)
+	log.record(Guess{Day: day, Part: part, Answer: answer, Verdict: resp.Verdict, Time: c.now()}, resp)
+	return resp, c.saveLog(year, log)
+}
+
+func (c *Client) now() time.Time {
+	if c.Now != nil {
+		return c.Now()
+	}
+	return time.Now()
+}
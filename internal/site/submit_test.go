@@ -0,0 +1,106 @@
+package site
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func page(article string) []byte {
+	return []byte("<html><body><main><article><p>" + article + "</p></article></main></body></html>")
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		article string
+		verdict Verdict
+		wait    time.Duration
+	}{
+		{`That's the right answer!  You are <em>one gold star</em> closer to finding the Chief Historian. <a href="/2024/day/5#part2">[Continue to Part Two]</a>`, Correct, 0},
+		{`That's not the right answer; your answer is too high.  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again. <a href="/2024/day/5">[Return to Day 5]</a>`, TooHigh, time.Minute},
+		{`That's not the right answer; your answer is too low.  Please wait 5 minutes before trying again.`, TooLow, 5 * time.Minute},
+		{`That's not the right answer.  If you're stuck, make sure you're using the full input data.`, Wrong, time.Minute},
+		{`You don't seem to be solving the right level.  Did you already complete it? <a href="/2024/day/5">[Return to Day 5]</a>`, AlreadySolved, 0},
+		{`You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 35s left to wait.`, RateLimited, 35 * time.Second},
+		{`You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 1m 5s left to wait.`, RateLimited, time.Minute + 5*time.Second},
+		{`Something new.`, Unknown, 0},
+	}
+
+	for _, tt := range tests {
+		resp := Classify(page(tt.article))
+		if resp.Verdict != tt.verdict || resp.Wait != tt.wait {
+			t.Errorf("Classify(%.40q) = %s, %s, want %s, %s", tt.article, resp.Verdict, resp.Wait, tt.verdict, tt.wait)
+		}
+		if strings.Contains(resp.Message, "<") {
+			t.Errorf("Message still contains markup: %q", resp.Message)
+		}
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	var posted []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2024/day/1/answer" || r.FormValue("level") != "1" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		answer := r.FormValue("answer")
+		posted = append(posted, answer)
+		switch answer {
+		case "11":
+			w.Write(page("That's the right answer!"))
+		case "20":
+			w.Write(page("That's not the right answer; your answer is too high.  Please wait one minute before trying again."))
+		default:
+			w.Write(page("That's not the right answer; your answer is too low."))
+		}
+	})
+
+	now := time.Date(2024, 12, 1, 6, 0, 0, 0, time.UTC)
+	c.Now = func() time.Time { return now }
+	ctx := context.Background()
+
+	resp, err := c.Submit(ctx, 2024, 1, 1, "20")
+	if err != nil || resp.Verdict != TooHigh {
+		t.Fatalf("Submit(20) = %v, %v, want too high", resp.Verdict, err)
+	}
+
+	if _, err := c.Submit(ctx, 2024, 1, 1, "5"); err == nil || !strings.Contains(err.Error(), "cooling down: wait 1m0s") {
+		t.Errorf("Submit during cooldown error = %v", err)
+	}
+
+	now = now.Add(2 * time.Minute)
+	for _, answer := range []string{"20", "25"} {
+		if _, err := c.Submit(ctx, 2024, 1, 1, answer); err == nil {
+			t.Errorf("Submit(%s) was sent despite the too high guess", answer)
+		}
+	}
+
+	resp, err = c.Submit(ctx, 2024, 1, 1, "5")
+	if err != nil || resp.Verdict != TooLow {
+		t.Fatalf("Submit(5) = %v, %v, want too low", resp.Verdict, err)
+	}
+	now = now.Add(2 * time.Minute)
+	if _, err := c.Submit(ctx, 2024, 1, 1, "3"); err == nil || !strings.Contains(err.Error(), "which was too low") {
+		t.Errorf("Submit(3) error = %v", err)
+	}
+
+	resp, err = c.Submit(ctx, 2024, 1, 1, "11")
+	if err != nil || resp.Verdict != Correct {
+		t.Fatalf("Submit(11) = %v, %v, want correct", resp.Verdict, err)
+	}
+
+	if got := strings.Join(posted, ","); got != "20,5,11" {
+		t.Errorf("posted %s, want 20,5,11", got)
+	}
+
+	log, err := c.LoadLog(2024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(log.Guesses) != 2 {
+		t.Errorf("log holds %d wrong guesses, want 2", len(log.Guesses))
+	}
+}
@@ -0,0 +1,216 @@
+// Package stress solves randomly generated inputs in a loop and reports the
+// ones a day fails on, so bugs the real input never triggers can be found
+// and replayed from their seed.
+package stress
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/reckerp/aoc-2024/gen"
+	"github.com/reckerp/aoc-2024/internal/inputs"
+	"github.com/reckerp/aoc-2024/internal/runner"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+// Case identifies one generated input.
+type Case struct {
+	Year int
+	Day  int
+	Size int
+	Seed uint64
+}
+
+func (c Case) String() string {
+	return fmt.Sprintf("%d day %d size %d seed %d", c.Year, c.Day, c.Size, c.Seed)
+}
+
+// Check inspects the outcome of solving a generated input and returns an
+// error if it is wrong, or ErrSkipped if it cannot tell.
+type Check func(c Case, in gen.Input, result runner.Day) error
+
+// ErrSkipped is returned by checks that could not check an input.
+var ErrSkipped = errors.New("skipped")
+
+// NoErrors is the default check: every part must be solved without an
+// error or a panic.
+func NoErrors(_ Case, _ gen.Input, result runner.Day) error {
+	var errs []error
+	for _, p := range result.Parts {
+		if p.Err != nil {
+			errs = append(errs, fmt.Errorf("part %d: %w", p.Part, p.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// MatchesReference returns a check that solves each input again with the
+// day's reference solutions and compares the answers, after checking that
+// the parts solved it without errors. Parts the reference cannot solve are
+// not compared; if no part is, the check returns ErrSkipped.
+func MatchesReference(s solver.Solver) Check {
+	return func(c Case, in gen.Input, result runner.Day) error {
+		if err := NoErrors(c, in, result); err != nil {
+			return err
+		}
+		model, err := s.Parse(bytes.NewReader(in.Data))
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		compared := 0
+		for _, p := range result.Parts {
+			want, err := reference(s, p.Part, model, in.Params)
+			switch {
+			case errors.Is(err, solver.ErrNoReference):
+				continue
+			case err != nil:
+				errs = append(errs, fmt.Errorf("part %d: reference: %w", p.Part, err))
+			case p.Answer != want:
+				errs = append(errs, fmt.Errorf("part %d: got %s, reference %s", p.Part, p.Answer, want))
+			}
+			compared++
+		}
+		if compared == 0 {
+			return ErrSkipped
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// reference solves a part with the day's reference solution, turning a
+// panic into an error as runner.Solve does for the part itself.
+func reference(s solver.Solver, part int, model any, params solver.Params) (answer string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return s.Reference(part, model, params)
+}
+
+// HasReference reports whether a day has a reference solution for either
+// part. Reference rejects parts without one before it looks at the model,
+// so none is needed.
+func HasReference(s solver.Solver) bool {
+	for part := 1; part <= 2; part++ {
+		if _, err := s.Reference(part, nil, nil); !errors.Is(err, solver.ErrNoReference) {
+			return true
+		}
+	}
+	return false
+}
+
+// Config controls a stress run.
+type Config struct {
+	// Runs is the number of inputs to generate.
+	Runs int
+	// MaxSize is the size of the largest input. Sizes cycle from 1 up to
+	// it, so small failing inputs turn up early.
+	MaxSize int
+	// Seed is the seed of the first input; each later one adds one.
+	Seed uint64
+	// Timeout bounds the time spent solving one input; zero means none.
+	Timeout time.Duration
+	// Check defaults to NoErrors.
+	Check Check
+}
+
+// Failure is a generated input that failed its check.
+type Failure struct {
+	Case
+	Input gen.Input
+	Err   error
+}
+
+// Report is the outcome of a stress run.
+type Report struct {
+	// Runs counts the inputs solved and checked, including those skipped.
+	Runs     int
+	Skipped  int
+	Failures []Failure
+}
+
+// Smallest returns the failure with the smallest input, by size and then
+// by length, or false if there are no failures.
+func (r Report) Smallest() (Failure, bool) {
+	if len(r.Failures) == 0 {
+		return Failure{}, false
+	}
+	return slices.MinFunc(r.Failures, func(a, b Failure) int {
+		if a.Size != b.Size {
+			return a.Size - b.Size
+		}
+		return len(a.Input.Data) - len(b.Input.Data)
+	}), true
+}
+
+// Run generates cfg.Runs inputs for a day of a year, solves both parts of each and
+// checks the outcome. It stops early when ctx is done, returning what it
+// found so far.
+//
+// A part that runs past the timeout cannot be stopped, so it is left
+// running in the background and the input is reported as a failure.
+func Run(ctx context.Context, year, day int, s solver.Solver, cfg Config) (Report, error) {
+	check := cfg.Check
+	if check == nil {
+		check = NoErrors
+	}
+
+	var report Report
+	for i := range cfg.Runs {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		c := Case{Year: year, Day: day, Size: 1 + i%max(cfg.MaxSize, 1), Seed: cfg.Seed + uint64(i)}
+		in, err := gen.Generate(c.Year, c.Day, c.Size, c.Seed)
+		if err != nil {
+			return report, err
+		}
+
+		err = solve(ctx, c, s, in, check, cfg.Timeout)
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+		report.Runs++
+		switch {
+		case errors.Is(err, ErrSkipped):
+			report.Skipped++
+		case err != nil:
+			report.Failures = append(report.Failures, Failure{Case: c, Input: in, Err: err})
+		}
+	}
+	return report, nil
+}
+
+// solve solves both parts of a generated input and checks the outcome,
+// giving up after timeout.
+func solve(ctx context.Context, c Case, s solver.Solver, in gen.Input, check Check, timeout time.Duration) error {
+	loader := &inputs.Loader{Path: c.String(), Data: in.Data, Overrides: in.Params}
+	done := make(chan error, 1)
+	go func() {
+		result := runner.Solve(c.Year, c.Day, s, []int{1, 2}, loader)
+		done <- check(c, in, result)
+	}()
+
+	var expired <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+	select {
+	case err := <-done:
+		return err
+	case <-expired:
+		return fmt.Errorf("not solved within %s", timeout)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
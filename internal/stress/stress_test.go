@@ -0,0 +1,122 @@
+package stress
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/reckerp/aoc-2024/d01"
+	"github.com/reckerp/aoc-2024/gen"
+	"github.com/reckerp/aoc-2024/internal/runner"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+func TestRun(t *testing.T) {
+	var sizes []int
+	check := func(c Case, in gen.Input, result runner.Day) error {
+		sizes = append(sizes, c.Size)
+		if err := NoErrors(c, in, result); err != nil {
+			return err
+		}
+		switch c.Seed {
+		case 12:
+			return errors.New("rejected")
+		case 13:
+			return ErrSkipped
+		}
+		return nil
+	}
+
+	report, err := Run(context.Background(), 2024, 1, d01.Solver, Config{Runs: 5, MaxSize: 2, Seed: 10, Check: check})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{1, 2, 1, 2, 1}; !slices.Equal(sizes, want) {
+		t.Errorf("sizes = %v, want %v", sizes, want)
+	}
+	if report.Runs != 5 || report.Skipped != 1 {
+		t.Errorf("got %d runs and %d skipped, want 5 and 1", report.Runs, report.Skipped)
+	}
+	failures := report.Failures
+	if len(failures) != 1 || failures[0].Seed != 12 || failures[0].Size != 1 {
+		t.Fatalf("failures = %+v, want only seed 12", failures)
+	}
+	in, _ := gen.Generate(2024, 1, 1, 12)
+	if string(failures[0].Input.Data) != string(in.Data) {
+		t.Error("failure does not carry its generated input")
+	}
+}
+
+func TestMatchesReference(t *testing.T) {
+	parse := func(io.Reader) (int, error) { return 0, nil }
+	answer := func(n int) func(int, solver.Params) (int, error) {
+		return func(int, solver.Params) (int, error) { return n, nil }
+	}
+	tests := []struct {
+		name    string
+		s       solver.Solver
+		want    string
+		skipped bool
+	}{
+		{"agree", solver.NewWithParams(parse, answer(1), answer(2), nil, solver.WithReference(answer(1), answer(2))), "", false},
+		{"disagree", solver.NewWithParams(parse, answer(1), answer(2), nil, solver.WithReference(answer(1), answer(3))), "part 2: got 2, reference 3", false},
+		{"part 1 only", solver.NewWithParams(parse, answer(1), answer(2), nil, solver.WithReference[int, int, int](answer(1), nil)), "", false},
+		{"none", solver.NewWithParams(parse, answer(1), answer(2), nil), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := Run(context.Background(), 2024, 1, tt.s, Config{Runs: 1, MaxSize: 1, Check: MatchesReference(tt.s)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (report.Skipped == 1) != tt.skipped {
+				t.Errorf("skipped %d, want skipped %v", report.Skipped, tt.skipped)
+			}
+			var got string
+			if len(report.Failures) > 0 {
+				got = report.Failures[0].Err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("got failure %q, want %q", got, tt.want)
+			}
+			if HasReference(tt.s) == tt.skipped {
+				t.Errorf("HasReference() = %v", !tt.skipped)
+			}
+		})
+	}
+}
+
+func TestSmallest(t *testing.T) {
+	report := Report{Failures: []Failure{
+		{Case: Case{Size: 3, Seed: 1}, Input: gen.Input{Data: []byte("a")}},
+		{Case: Case{Size: 2, Seed: 2}, Input: gen.Input{Data: []byte("bbb")}},
+		{Case: Case{Size: 2, Seed: 3}, Input: gen.Input{Data: []byte("cc")}},
+	}}
+	if f, ok := report.Smallest(); !ok || f.Seed != 3 {
+		t.Errorf("Smallest() = %+v, %v, want seed 3", f, ok)
+	}
+	if _, ok := (Report{}).Smallest(); ok {
+		t.Error("Smallest() of no failures reported one")
+	}
+}
+
+func TestRunTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	slow := solver.New(
+		func(io.Reader) (int, error) { return 0, nil },
+		func(int) (int, error) { <-release; return 0, nil },
+		func(int) (int, error) { return 0, nil },
+	)
+
+	report, err := Run(context.Background(), 2024, 1, slow, Config{Runs: 1, MaxSize: 1, Timeout: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Failures) != 1 {
+		t.Fatalf("failures = %+v, want the timed out input", report.Failures)
+	}
+}
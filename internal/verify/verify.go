@@ -0,0 +1,140 @@
+// Package verify re-runs solvers and compares their output with the stored
+// answers.
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/reckerp/aoc-2024/internal/answers"
+	"github.com/reckerp/aoc-2024/internal/params"
+	"github.com/reckerp/aoc-2024/registry"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+type Status string
+
+const (
+	Pass    Status = "pass"
+	Fail    Status = "fail"
+	Missing Status = "missing"
+)
+
+// Result is the outcome of verifying one part of one day.
+type Result struct {
+	Year   int
+	Day    int
+	Part   int
+	Status Status
+	Got    string
+	Want   string
+	// Reason explains a Fail or Missing status.
+	Reason string
+}
+
+func (r Result) String() string {
+	switch r.Status {
+	case Pass:
+		return r.Got
+	case Fail:
+		if r.Reason != "" {
+			return r.Reason
+		}
+		return fmt.Sprintf("got %s, want %s", r.Got, r.Want)
+	}
+	return r.Reason
+}
+
+// Day verifies both parts of a day of a year against the stored answers,
+// reading the puzzle input from the day's directory below root and its
+// params from the params file there.
+func Day(root string, year, day int, ans answers.Answers) []Result {
+	results := []Result{
+		{Year: year, Day: day, Part: 1},
+		{Year: year, Day: day, Part: 2},
+	}
+
+	s, ok := registry.Lookup(year, day)
+	if !ok {
+		return setAll(results, Missing, "no solver")
+	}
+
+	input, err := solver.ParseFile(s, filepath.Join(root, registry.InputPath(year, day)))
+	if errors.Is(err, os.ErrNotExist) {
+		return setAll(results, Missing, "no input file")
+	}
+	if err != nil {
+		return setAll(results, Fail, err.Error())
+	}
+	cfg, err := params.Load(filepath.Join(root, params.File))
+	if err != nil {
+		return setAll(results, Fail, err.Error())
+	}
+	p := cfg.For(s, year, day, false, nil)
+
+	for i, r := range results {
+		if _, ok := ans.Get(year, day, r.Part); !ok {
+			results[i] = Compare(year, day, r.Part, "", nil, ans)
+			continue
+		}
+		got, err := solve(s, r.Part, input, p)
+		results[i] = Compare(year, day, r.Part, got, err, ans)
+	}
+	return results
+}
+
+// solve runs a part, turning a panic into its error so that one broken day
+// fails on its own instead of taking verify, or the dashboard, down with it.
+func solve(s solver.Solver, part int, input any, p solver.Params) (got string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return s.Solve(part, input, p)
+}
+
+// Compare checks an answer computed elsewhere, or the error that prevented
+// it, against the stored answer for a part of a day.
+func Compare(year, day, part int, got string, err error, ans answers.Answers) Result {
+	r := Result{Year: year, Day: day, Part: part, Got: got}
+	want, ok := ans.Get(year, day, part)
+	switch {
+	case !ok:
+		r.Status, r.Reason = Missing, "no stored answer"
+	case err != nil:
+		r.Status, r.Reason, r.Want = Fail, err.Error(), want
+	case got != want:
+		r.Status, r.Want = Fail, want
+	default:
+		r.Status, r.Want = Pass, want
+	}
+	return r
+}
+
+// Year verifies every registered day of a year in order.
+func Year(root string, year int, ans answers.Answers) []Result {
+	var results []Result
+	for _, day := range registry.Days(year) {
+		results = append(results, Day(root, year, day, ans)...)
+	}
+	return results
+}
+
+// All verifies every registered day of every year in order.
+func All(root string, ans answers.Answers) []Result {
+	var results []Result
+	for _, year := range registry.Years() {
+		results = append(results, Year(root, year, ans)...)
+	}
+	return results
+}
+
+func setAll(results []Result, status Status, reason string) []Result {
+	for i := range results {
+		results[i].Status, results[i].Reason = status, reason
+	}
+	return results
+}
@@ -0,0 +1,84 @@
+package verify
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/internal/answers"
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+// TestStoredAnswers re-runs every day that has both a puzzle input and a
+// stored answer, so CI catches refactors that change an accepted answer.
+func TestStoredAnswers(t *testing.T) {
+	root := filepath.Join("..", "..")
+	ans, err := answers.Load(filepath.Join(root, "answers.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range All(root, ans) {
+		t.Run(fmt.Sprintf("%d/day%02d/part%d", r.Year, r.Day, r.Part), func(t *testing.T) {
+			switch r.Status {
+			case Missing:
+				t.Skip(r.Reason)
+			case Fail:
+				t.Error(r)
+			}
+		})
+	}
+}
+
+func TestDay(t *testing.T) {
+	root := t.TempDir()
+	example, err := os.ReadFile(filepath.Join("..", "..", "d01", "testdata", "example.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(root, "d01"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "d01", "input.txt"), example, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ans := answers.Answers{}
+	ans.Set(2024, 1, 1, "11")
+	ans.Set(2024, 1, 2, "30")
+
+	results := Day(root, 2024, 1, ans)
+	if results[0].Status != Pass {
+		t.Errorf("part 1: got %s (%s), want pass", results[0].Status, results[0])
+	}
+	if results[1].Status != Fail || results[1].String() != "got 31, want 30" {
+		t.Errorf("part 2: got %s (%s), want fail with diff", results[1].Status, results[1])
+	}
+
+	for _, r := range Day(root, 2024, 2, ans) {
+		if r.Status != Missing {
+			t.Errorf("day 2 part %d: got %s, want missing", r.Part, r.Status)
+		}
+	}
+	for _, r := range Day(root, 2025, 1, ans) {
+		if r.Status != Missing || r.Reason != "no solver" {
+			t.Errorf("2025 day 1 part %d: got %s (%s), want missing with no solver", r.Part, r.Status, r)
+		}
+	}
+}
+
+func TestSolveRecoversPanic(t *testing.T) {
+	s := solver.New(
+		func(io.Reader) (int, error) { return 0, nil },
+		func(int) (int, error) { panic("boom") },
+		func(int) (int, error) { return 2, nil },
+	)
+	if _, err := solve(s, 1, 0, nil); err == nil || err.Error() != "panic: boom" {
+		t.Errorf("part 1: got error %v, want panic: boom", err)
+	}
+	if got, err := solve(s, 2, 0, nil); err != nil || got != "2" {
+		t.Errorf("part 2: got %q, %v, want 2", got, err)
+	}
+}
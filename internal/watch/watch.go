@@ -0,0 +1,123 @@
+// Package watch polls files for changes by comparing their modification
+// times and sizes. Polling needs nothing beyond the standard library and
+// behaves the same on every platform, which matters more here than reacting
+// within milliseconds.
+package watch
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/reckerp/aoc-2024/registry"
+)
+
+// DayFiles returns the files the results of a day of a year depend on: the
+// Go sources and test fixtures in its directory below root, its input file,
+// and any extra paths. The input is listed even when it does not exist yet,
+// so creating it counts as a change.
+func DayFiles(root string, year, day int, input string, extra ...string) ([]string, error) {
+	dir := filepath.Join(root, registry.Dir(year, day))
+	sources, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	fixtures, err := filepath.Glob(filepath.Join(dir, "testdata", "*"))
+	if err != nil {
+		return nil, err
+	}
+	if input == "" {
+		input = filepath.Join(dir, "input.txt")
+	}
+
+	files := slices.Concat(sources, fixtures, []string{input}, extra)
+	slices.Sort(files)
+	return slices.Compact(files), nil
+}
+
+type stamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// Snapshot records the state of a set of files. Files that do not exist are
+// recorded as absent.
+type Snapshot map[string]stamp
+
+// Take records the current state of paths.
+func Take(paths []string) (Snapshot, error) {
+	s := make(Snapshot, len(paths))
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			s[path] = stamp{}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		s[path] = stamp{modTime: info.ModTime(), size: info.Size()}
+	}
+	return s, nil
+}
+
+// Changed returns the sorted paths that were created, modified or removed
+// between s and next, including paths only one of them records.
+func (s Snapshot) Changed(next Snapshot) []string {
+	var changed []string
+	for path, st := range next {
+		if prev, ok := s[path]; !ok || !prev.modTime.Equal(st.modTime) || prev.size != st.size {
+			changed = append(changed, path)
+		}
+	}
+	for path := range s {
+		if _, ok := next[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	slices.Sort(changed)
+	return changed
+}
+
+// Poll calls files every interval to learn which paths to watch, so new
+// files are picked up, and calls onChange with the paths that changed since
+// the previous poll. onChange runs synchronously; changes made while it runs
+// are reported by the next poll. Poll returns when ctx is done or when files
+// fails.
+func Poll(ctx context.Context, interval time.Duration, files func() ([]string, error), onChange func(changed []string)) error {
+	take := func() (Snapshot, error) {
+		paths, err := files()
+		if err != nil {
+			return nil, err
+		}
+		return Take(paths)
+	}
+
+	prev, err := take()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		next, err := take()
+		if err != nil {
+			return err
+		}
+		if changed := prev.Changed(next); len(changed) > 0 {
+			onChange(changed)
+		}
+		prev = next
+	}
+}
@@ -0,0 +1,156 @@
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func write(t *testing.T, path, data string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDayFiles(t *testing.T) {
+	root := t.TempDir()
+	write(t, filepath.Join(root, "d05", "d05.go"), "package d05")
+	write(t, filepath.Join(root, "d05", "d05_test.go"), "package d05")
+	write(t, filepath.Join(root, "d05", "notes.md"), "")
+	write(t, filepath.Join(root, "d05", "testdata", "example.txt"), "")
+	write(t, filepath.Join(root, "d06", "d06.go"), "package d06")
+
+	got, err := DayFiles(root, 2024, 5, "", filepath.Join(root, "answers.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		filepath.Join(root, "answers.json"),
+		filepath.Join(root, "d05", "d05.go"),
+		filepath.Join(root, "d05", "d05_test.go"),
+		filepath.Join(root, "d05", "input.txt"),
+		filepath.Join(root, "d05", "testdata", "example.txt"),
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("DayFiles() = %q, want %q", got, want)
+	}
+
+	got, err = DayFiles(root, 2024, 5, "other.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Contains(got, "other.txt") || slices.Contains(got, filepath.Join(root, "d05", "input.txt")) {
+		t.Errorf("DayFiles() with an input path = %q", got)
+	}
+
+	write(t, filepath.Join(root, "2025", "d05", "d05.go"), "package d05")
+	got, err = DayFiles(root, 2025, 5, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []string{
+		filepath.Join(root, "2025", "d05", "d05.go"),
+		filepath.Join(root, "2025", "d05", "input.txt"),
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("DayFiles() for 2025 = %q, want %q", got, want)
+	}
+}
+
+func TestChanged(t *testing.T) {
+	dir := t.TempDir()
+	kept := filepath.Join(dir, "kept")
+	touched := filepath.Join(dir, "touched")
+	grown := filepath.Join(dir, "grown")
+	created := filepath.Join(dir, "created")
+	removed := filepath.Join(dir, "removed")
+	for _, path := range []string{kept, touched, grown, removed} {
+		write(t, path, "x")
+	}
+	paths := []string{kept, touched, grown, created, removed}
+
+	before, err := Take(paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(touched, later, later); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(grown)
+	if err != nil {
+		t.Fatal(err)
+	}
+	write(t, grown, "xx")
+	if err := os.Chtimes(grown, info.ModTime(), info.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+	write(t, created, "x")
+	if err := os.Remove(removed); err != nil {
+		t.Fatal(err)
+	}
+
+	after, err := Take(paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{created, grown, removed, touched}
+	if got := before.Changed(after); !slices.Equal(got, want) {
+		t.Errorf("Changed() = %q, want %q", got, want)
+	}
+	if got := after.Changed(after); len(got) != 0 {
+		t.Errorf("Changed() against itself = %q, want none", got)
+	}
+	if got := after.Changed(Snapshot{}); !slices.Equal(got, slices.Sorted(slices.Values(paths))) {
+		t.Errorf("Changed() to an empty snapshot = %q, want every path", got)
+	}
+}
+
+func TestPoll(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "input.txt")
+	files := func() ([]string, error) { return []string{path}, nil }
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	pollCtx, stop := context.WithCancel(ctx)
+	changes := make(chan []string, 1)
+	done := make(chan error, 1)
+	go func() {
+		done <- Poll(pollCtx, time.Millisecond, files, func(changed []string) {
+			select {
+			case changes <- changed:
+			default:
+			}
+			stop()
+		})
+	}()
+
+	// The first snapshot may be taken after the write, so keep changing
+	// the file until a poll notices.
+	for i := 0; ; i++ {
+		select {
+		case got := <-changes:
+			if !slices.Equal(got, []string{path}) {
+				t.Errorf("onChange(%q), want %q", got, path)
+			}
+			if err := <-done; err != nil {
+				t.Errorf("Poll() = %v", err)
+			}
+			return
+		case <-ctx.Done():
+			t.Fatal("no change reported")
+		case <-time.After(10 * time.Millisecond):
+			write(t, path, string(make([]byte, i+1)))
+		}
+	}
+}
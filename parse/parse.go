@@ -0,0 +1,345 @@
+// Package parse provides line scanning, tokenising and positioned errors
+// shared by the puzzle input parsers.
+package parse
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Error describes malformed puzzle input. Line and Col are 1-based; Col is
+// zero when the problem concerns a whole line or the input as a whole.
+type Error struct {
+	File     string
+	Line     int
+	Col      int
+	Expected string
+	Found    string
+}
+
+func (e *Error) Error() string {
+	file := e.File
+	if file == "" {
+		file = "<input>"
+	}
+
+	var pos string
+	switch {
+	case e.Line == 0:
+		pos = file
+	case e.Col == 0:
+		pos = fmt.Sprintf("%s:%d", file, e.Line)
+	default:
+		pos = fmt.Sprintf("%s:%d:%d", file, e.Line, e.Col)
+	}
+
+	found := strconv.Quote(e.Found)
+	if e.Found == "" {
+		switch {
+		case e.Line == 0:
+			found = "end of input"
+		case e.Col == 0:
+			found = "empty line"
+		default:
+			found = "end of line"
+		}
+	}
+	return fmt.Sprintf("%s: expected %s, found %s", pos, e.Expected, found)
+}
+
+// InFile records the input file name on a parse error that does not carry
+// one yet. Parsers only see an io.Reader, so the caller that opened the
+// input supplies the name.
+func InFile(err error, file string) error {
+	var pe *Error
+	if errors.As(err, &pe) && pe.File == "" {
+		pe.File = file
+	}
+	return err
+}
+
+// Field is a token of an input line along with its 1-based line and column.
+// Line is zero for fields that are not tied to a line.
+type Field struct {
+	Text string
+	Line int
+	Col  int
+}
+
+// Fields splits the field around runs of spaces and tabs, like
+// strings.Fields, keeping the column of each part.
+func (f Field) Fields() []Field {
+	var fields []Field
+	start := -1
+	for i, r := range f.Text {
+		space := r == ' ' || r == '\t'
+		switch {
+		case !space && start < 0:
+			start = i
+		case space && start >= 0:
+			fields = append(fields, Field{Text: f.Text[start:i], Line: f.Line, Col: f.Col + start})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		fields = append(fields, Field{Text: f.Text[start:], Line: f.Line, Col: f.Col + start})
+	}
+	return fields
+}
+
+// Split slices the field around each instance of sep, keeping the column
+// of each part.
+func (f Field) Split(sep string) []Field {
+	parts := strings.Split(f.Text, sep)
+	fields := make([]Field, len(parts))
+	col := f.Col
+	for i, part := range parts {
+		fields[i] = Field{Text: part, Line: f.Line, Col: col}
+		col += len(part) + len(sep)
+	}
+	return fields
+}
+
+// Cut slices the field around the first instance of sep.
+func (f Field) Cut(sep string) (before, after Field, found bool) {
+	b, a, found := strings.Cut(f.Text, sep)
+	before = Field{Text: b, Line: f.Line, Col: f.Col}
+	after = Field{Text: a, Line: f.Line, Col: f.Col + len(b) + len(sep)}
+	return before, after, found
+}
+
+// TrimPrefix removes prefix from the field, reporting whether it was there.
+func (f Field) TrimPrefix(prefix string) (Field, bool) {
+	if !strings.HasPrefix(f.Text, prefix) {
+		return f, false
+	}
+	return Field{Text: f.Text[len(prefix):], Line: f.Line, Col: f.Col + len(prefix)}, true
+}
+
+// TrimSpace removes leading and trailing spaces and tabs from the field.
+func (f Field) TrimSpace() Field {
+	text := strings.TrimLeft(f.Text, " \t")
+	col := f.Col + len(f.Text) - len(text)
+	return Field{Text: strings.TrimRight(text, " \t"), Line: f.Line, Col: col}
+}
+
+// Scanner reads input line by line, tracking line numbers for errors.
+type Scanner struct {
+	sc   *bufio.Scanner
+	line int
+	eof  bool
+}
+
+// NewScanner returns a Scanner reading from r.
+func NewScanner(r io.Reader) *Scanner {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	return &Scanner{sc: sc}
+}
+
+// Scan advances to the next line.
+func (s *Scanner) Scan() bool {
+	if !s.sc.Scan() {
+		s.eof = true
+		return false
+	}
+	s.line++
+	return true
+}
+
+// Text returns the current line.
+func (s *Scanner) Text() string {
+	return s.sc.Text()
+}
+
+// Field returns the current line as a Field starting at column 1.
+func (s *Scanner) Field() Field {
+	return Field{Text: s.sc.Text(), Line: s.line, Col: 1}
+}
+
+// Line returns the 1-based number of the current line.
+func (s *Scanner) Line() int {
+	return s.line
+}
+
+// Err returns the first non-EOF error encountered while reading.
+func (s *Scanner) Err() error {
+	return s.sc.Err()
+}
+
+// Errorf returns an Error for the field f on its line, or on the current
+// line if f does not record one.
+func (s *Scanner) Errorf(f Field, expected string, args ...any) error {
+	line := f.Line
+	if line == 0 {
+		line = s.line
+	}
+	return &Error{Line: line, Col: f.Col, Expected: fmt.Sprintf(expected, args...), Found: f.Text}
+}
+
+// LineErrorf returns an Error concerning the whole current line.
+func (s *Scanner) LineErrorf(expected string, args ...any) error {
+	return &Error{Line: s.line, Expected: fmt.Sprintf(expected, args...), Found: s.Text()}
+}
+
+// Int parses f as a base-10 integer.
+func (s *Scanner) Int(f Field) (int, error) {
+	n, err := strconv.Atoi(f.Text)
+	if err != nil {
+		return 0, s.Errorf(f, "integer")
+	}
+	return n, nil
+}
+
+// Ints parses the parts of f separated by sep, or by runs of spaces and
+// tabs when sep is empty, as base-10 integers with an optional sign, so
+// that "-3,4" split at commas gives -3 and 4. An empty f gives no integers.
+func (s *Scanner) Ints(f Field, sep string) ([]int, error) {
+	return s.ints(f, sep, false)
+}
+
+// Naturals is like Ints but rejects negative numbers.
+func (s *Scanner) Naturals(f Field, sep string) ([]int, error) {
+	return s.ints(f, sep, true)
+}
+
+func (s *Scanner) ints(f Field, sep string, natural bool) ([]int, error) {
+	var parts []Field
+	if sep == "" {
+		parts = f.Fields()
+	} else if f.Text != "" {
+		parts = f.Split(sep)
+	}
+
+	nums := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := s.Int(part)
+		if err != nil {
+			return nil, err
+		}
+		if natural && n < 0 {
+			return nil, s.Errorf(part, "non-negative integer")
+		}
+		nums[i] = n
+	}
+	return nums, nil
+}
+
+// Section reads the next section of the input: the lines up to the next
+// blank line or the end of input, after skipping any blank lines before
+// them. It returns no lines once the input is exhausted. Each line records
+// its line number, so errors can still point at it after the section has
+// been read.
+func (s *Scanner) Section() ([]Field, error) {
+	var lines []Field
+	for s.Scan() {
+		line := s.Field()
+		if line.Text == "" {
+			if len(lines) > 0 {
+				break
+			}
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, s.Err()
+}
+
+// Record reads the next section as a record of "key: value" lines with
+// exactly the given keys in that order, and returns their values with the
+// surrounding spaces removed. Like Section, it returns nil once the input
+// is exhausted.
+func (s *Scanner) Record(keys ...string) ([]Field, error) {
+	lines, err := s.Section()
+	if err != nil || lines == nil {
+		return nil, err
+	}
+
+	values := make([]Field, len(keys))
+	for i, key := range keys {
+		if i == len(lines) {
+			if s.eof {
+				return nil, EOFErrorf("%s: ...", key)
+			}
+			return nil, s.LineErrorf("%s: ...", key)
+		}
+		name, value, ok := lines[i].Cut(":")
+		if !ok || name.Text != key {
+			return nil, &Error{Line: lines[i].Line, Expected: key + ": ...", Found: lines[i].Text}
+		}
+		values[i] = value.TrimSpace()
+	}
+	if len(lines) > len(keys) {
+		extra := lines[len(keys)]
+		return nil, &Error{Line: extra.Line, Expected: "blank line", Found: extra.Text}
+	}
+	return values, nil
+}
+
+// EOFErrorf returns an Error for input that ends before something required
+// appears.
+func EOFErrorf(expected string, args ...any) error {
+	return &Error{Expected: fmt.Sprintf(expected, args...)}
+}
+
+// Grid reads lines up to the next blank line or the end of input and checks
+// that they form a non-empty rectangle. When allowed is not empty, every
+// character must be one of its bytes.
+func (s *Scanner) Grid(allowed string) ([]string, error) {
+	var rows []string
+	width := 0
+	for s.Scan() {
+		line := s.Text()
+		if line == "" {
+			break
+		}
+		// Cells are runes, so rows with multi-byte characters are only
+		// as wide as their rune count.
+		if n := utf8.RuneCountInString(line); len(rows) == 0 {
+			width = n
+		} else if n != width {
+			return nil, s.LineErrorf("row of %d cells", width)
+		}
+		if allowed != "" {
+			if i := strings.IndexFunc(line, func(r rune) bool { return !strings.ContainsRune(allowed, r) }); i >= 0 {
+				_, size := utf8.DecodeRuneInString(line[i:])
+				col := utf8.RuneCountInString(line[:i]) + 1
+				return nil, s.Errorf(Field{Text: line[i : i+size], Col: col}, "one of %q", allowed)
+			}
+		}
+		rows = append(rows, line)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, EOFErrorf("grid")
+	}
+	return rows, nil
+}
+
+// End checks that nothing but blank lines remains in the input.
+func (s *Scanner) End() error {
+	for s.Scan() {
+		if s.Text() != "" {
+			return s.LineErrorf("end of input")
+		}
+	}
+	return s.Err()
+}
+
+// Grid reads an input that consists of a single grid, as described by
+// Scanner.Grid.
+func Grid(r io.Reader, allowed string) ([]string, error) {
+	s := NewScanner(r)
+	rows, err := s.Grid(allowed)
+	if err != nil {
+		return nil, err
+	}
+	return rows, s.End()
+}
@@ -0,0 +1,188 @@
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestErrorString(t *testing.T) {
+	tests := []struct {
+		err  *Error
+		want string
+	}{
+		{&Error{File: "in.txt", Line: 2, Col: 3, Expected: "integer", Found: "x"}, `in.txt:2:3: expected integer, found "x"`},
+		{&Error{Line: 4, Expected: "X,Y", Found: "1"}, `<input>:4: expected X,Y, found "1"`},
+		{&Error{Line: 4, Expected: "report levels"}, `<input>:4: expected report levels, found empty line`},
+		{&Error{Line: 1, Col: 5, Expected: "number"}, `<input>:1:5: expected number, found end of line`},
+		{&Error{Expected: "grid"}, `<input>: expected grid, found end of input`},
+	}
+
+	for _, tt := range tests {
+		if got := tt.err.Error(); got != tt.want {
+			t.Errorf("Error() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestInFile(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", &Error{Line: 1, Expected: "x"})
+	InFile(err, "a.txt")
+	InFile(err, "b.txt")
+
+	var pe *Error
+	if !errors.As(err, &pe) || pe.File != "a.txt" {
+		t.Fatalf("InFile did not keep the first file name: %v", err)
+	}
+}
+
+func TestFields(t *testing.T) {
+	f := Field{Text: "  ab\tc  d", Col: 1}
+	var got []string
+	for _, field := range f.Fields() {
+		got = append(got, fmt.Sprintf("%s@%d", field.Text, field.Col))
+	}
+	if want := "ab@3 c@6 d@9"; strings.Join(got, " ") != want {
+		t.Errorf("Fields() = %v, want %s", got, want)
+	}
+
+	parts := Field{Text: "1,22,3", Col: 4}.Split(",")
+	if parts[2].Text != "3" || parts[2].Col != 9 {
+		t.Errorf("Split() third part = %+v, want 3@9", parts[2])
+	}
+
+	before, after, ok := Field{Text: "Prize: X=1", Line: 3, Col: 1}.Cut(":")
+	if !ok || before.Text != "Prize" || after.Text != " X=1" || after.Line != 3 || after.Col != 7 {
+		t.Errorf("Cut() = %+v, %+v, %v", before, after, ok)
+	}
+
+	if got := (Field{Text: " \tA: 1 ", Line: 2, Col: 3}).TrimSpace(); got != (Field{Text: "A: 1", Line: 2, Col: 5}) {
+		t.Errorf("TrimSpace() = %+v, want A: 1@2:5", got)
+	}
+}
+
+func TestInts(t *testing.T) {
+	tests := []struct {
+		text    string
+		sep     string
+		natural bool
+		want    []int
+		err     string
+	}{
+		{"3   4", "", false, []int{3, 4}, ""},
+		{"-3,4", ",", false, []int{-3, 4}, ""},
+		{"+7 -0", "", true, []int{7, 0}, ""},
+		{"", ",", false, []int{}, ""},
+		{"  ", "", false, []int{}, ""},
+		{"1,x,3", ",", false, nil, `<input>:2:3: expected integer, found "x"`},
+		{"1,,3", ",", false, nil, `<input>:2:3: expected integer, found end of line`},
+		{"5 -2", "", true, nil, `<input>:2:3: expected non-negative integer, found "-2"`},
+		{"99999999999999999999", "", false, nil, `<input>:2:1: expected integer, found "99999999999999999999"`},
+	}
+
+	for _, tt := range tests {
+		s := NewScanner(strings.NewReader("skip\n" + tt.text + "\n"))
+		s.Scan()
+		s.Scan()
+		ints := s.Ints
+		if tt.natural {
+			ints = s.Naturals
+		}
+		got, err := ints(s.Field(), tt.sep)
+		msg := ""
+		if err != nil {
+			msg = err.Error()
+		}
+		if msg != tt.err || !slices.Equal(got, tt.want) {
+			t.Errorf("Ints(%q, %q) = %v, %q, want %v, %q", tt.text, tt.sep, got, msg, tt.want, tt.err)
+		}
+	}
+}
+
+func TestSection(t *testing.T) {
+	s := NewScanner(strings.NewReader("\na\nb\n\n\nc\n"))
+	var got []string
+	for {
+		lines, err := s.Section()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if lines == nil {
+			break
+		}
+		var section []string
+		for _, line := range lines {
+			section = append(section, fmt.Sprintf("%s@%d", line.Text, line.Line))
+		}
+		got = append(got, strings.Join(section, " "))
+	}
+	if want := []string{"a@2 b@3", "c@6"}; !slices.Equal(got, want) {
+		t.Errorf("sections = %q, want %q", got, want)
+	}
+}
+
+func TestRecord(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"A: 1\nB:  x y \n", "1@1:4 x y@2:5"},
+		{"\n\nA: 1\nB: 2\n\nC: 3\n", "1@3:4 2@4:4"},
+		{"", ""},
+		{"A: 1\n", "<input>: expected B: ..., found end of input"},
+		{"A: 1\n\nB: 2\n", "<input>:2: expected B: ..., found empty line"},
+		{"A: 1\nC: 2\n", `<input>:2: expected B: ..., found "C: 2"`},
+		{"A 1\n", `<input>:1: expected A: ..., found "A 1"`},
+		{"A: 1\nB: 2\nC: 3\n", `<input>:3: expected blank line, found "C: 3"`},
+	}
+
+	for _, tt := range tests {
+		values, err := NewScanner(strings.NewReader(tt.input)).Record("A", "B")
+		var got string
+		if err != nil {
+			got = err.Error()
+		}
+		for i, v := range values {
+			if i > 0 {
+				got += " "
+			}
+			got += fmt.Sprintf("%s@%d:%d", v.Text, v.Line, v.Col)
+		}
+		if got != tt.want {
+			t.Errorf("Record(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestGrid(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"..#\n#..\n", ""},
+		{"..#\n#..\n\n\n", ""},
+		{"", "<input>: expected grid, found end of input"},
+		{"..#\n#.\n", `<input>:2: expected row of 3 cells, found "#."`},
+		{"..#\n#x.\n", `<input>:2:2: expected one of ".#", found "x"`},
+		{"..#\n\nmore\n", `<input>:3: expected end of input, found "more"`},
+	}
+
+	for _, tt := range tests {
+		_, err := Grid(strings.NewReader(tt.input), ".#")
+		got := ""
+		if err != nil {
+			got = err.Error()
+		}
+		if got != tt.want {
+			t.Errorf("Grid(%q) error = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+
+	// The column counts cells, not bytes, past multi-byte characters.
+	_, err := Grid(strings.NewReader("éé.\n.éx\n"), ".é")
+	if want := `<input>:2:3: expected one of ".é", found "x"`; err == nil || err.Error() != want {
+		t.Errorf("Grid with multi-byte cells error = %v, want %q", err, want)
+	}
+}
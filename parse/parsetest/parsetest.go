@@ -0,0 +1,43 @@
+// Package parsetest provides helpers for testing the parsers of the days.
+package parsetest
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/parse"
+)
+
+// Fuzz fuzzes the parser of a day from the current test. It checks that no
+// input makes it panic and that every rejected input is reported as a
+// positioned parse error. The corpus starts from the examples in testdata
+// and the given seeds.
+func Fuzz[T any](f *testing.F, parseInput func(io.Reader) (T, error), seeds ...string) {
+	f.Helper()
+	examples, err := filepath.Glob(filepath.Join("testdata", "*.txt"))
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, name := range examples {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(data)
+	}
+	for _, seed := range seeds {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, err := parseInput(bytes.NewReader(data))
+		var pe *parse.Error
+		if err != nil && !errors.As(err, &pe) {
+			t.Errorf("Parse error %q is not a *parse.Error", err)
+		}
+	})
+}
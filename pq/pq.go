@@ -0,0 +1,74 @@
+// Package pq provides a typed binary-heap priority queue.
+package pq
+
+// Queue is a min-priority queue ordered by a less function. The zero value
+// is not usable; create queues with New.
+type Queue[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+// New returns an empty queue that pops the item for which less reports
+// true against every other item first.
+func New[T any](less func(a, b T) bool) *Queue[T] {
+	return &Queue[T]{less: less}
+}
+
+// Len returns the number of queued items.
+func (q *Queue[T]) Len() int {
+	return len(q.items)
+}
+
+// Push adds an item to the queue.
+func (q *Queue[T]) Push(item T) {
+	q.items = append(q.items, item)
+	q.up(len(q.items) - 1)
+}
+
+// Pop removes and returns the smallest item. It panics if the queue is
+// empty.
+func (q *Queue[T]) Pop() T {
+	n := len(q.items) - 1
+	top := q.items[0]
+	q.items[0] = q.items[n]
+	var zero T
+	q.items[n] = zero
+	q.items = q.items[:n]
+	q.down(0)
+	return top
+}
+
+// Peek returns the smallest item without removing it. It panics if the
+// queue is empty.
+func (q *Queue[T]) Peek() T {
+	return q.items[0]
+}
+
+func (q *Queue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.less(q.items[i], q.items[parent]) {
+			return
+		}
+		q.items[i], q.items[parent] = q.items[parent], q.items[i]
+		i = parent
+	}
+}
+
+func (q *Queue[T]) down(i int) {
+	n := len(q.items)
+	for {
+		smallest := i
+		if l := 2*i + 1; l < n && q.less(q.items[l], q.items[smallest]) {
+			smallest = l
+		}
+		if r := 2*i + 2; r < n && q.less(q.items[r], q.items[smallest]) {
+			smallest = r
+		}
+		if smallest == i {
+			return
+		}
+		q.items[i], q.items[smallest] = q.items[smallest], q.items[i]
+		i = smallest
+	}
+}
@@ -0,0 +1,57 @@
+package pq
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestQueueOrder(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	values := make([]int, 200)
+	for i := range values {
+		values[i] = rng.IntN(50)
+	}
+
+	q := New(func(a, b int) bool { return a < b })
+	for _, v := range values {
+		q.Push(v)
+	}
+	if q.Len() != len(values) {
+		t.Fatalf("Len() = %d, want %d", q.Len(), len(values))
+	}
+
+	var got []int
+	for q.Len() > 0 {
+		peek := q.Peek()
+		v := q.Pop()
+		if peek != v {
+			t.Fatalf("Peek() = %d, but Pop() = %d", peek, v)
+		}
+		got = append(got, v)
+	}
+
+	slices.Sort(values)
+	if !slices.Equal(got, values) {
+		t.Errorf("popped %v, want %v", got, values)
+	}
+}
+
+func TestQueueSorts(t *testing.T) {
+	type item struct {
+		name     string
+		priority int
+	}
+	q := New(func(a, b item) bool { return a.priority < b.priority })
+	for _, it := range []item{{"c", 3}, {"a", 1}, {"d", 4}, {"b", 2}, {"e", 5}} {
+		q.Push(it)
+	}
+
+	var got []string
+	for q.Len() > 0 {
+		got = append(got, q.Pop().name)
+	}
+	if want := []string{"a", "b", "c", "d", "e"}; !slices.Equal(got, want) {
+		t.Errorf("popped %v, want %v", got, want)
+	}
+}
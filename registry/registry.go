@@ -0,0 +1,70 @@
+// Package registry maps each day of every year's calendar to its solver.
+package registry
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/reckerp/aoc-2024/solver"
+)
+
+// The years map lives in days.go, which "aoc new" regenerates from the day
+// directories whenever it adds a day.
+
+// HomeYear is the year the repository started out with. Its days live in
+// dNN directories at the repository root; every other year keeps them in
+// a directory named after the year, such as 2025/d01.
+const HomeYear = 2024
+
+// Lookup returns the solver registered for the given day of a year.
+func Lookup(year, day int) (solver.Solver, bool) {
+	s, ok := years[year][day]
+	return s, ok
+}
+
+// Days returns every registered day of a year in ascending order.
+func Days(year int) []int {
+	result := make([]int, 0, len(years[year]))
+	for day := range years[year] {
+		result = append(result, day)
+	}
+	sort.Ints(result)
+	return result
+}
+
+// Years returns every year with registered days in ascending order.
+func Years() []int {
+	result := make([]int, 0, len(years))
+	for year := range years {
+		result = append(result, year)
+	}
+	sort.Ints(result)
+	return result
+}
+
+// Latest returns the most recent year with registered days, which commands
+// work on unless told otherwise, or HomeYear if there are none.
+func Latest() int {
+	if all := Years(); len(all) > 0 {
+		return all[len(all)-1]
+	}
+	return HomeYear
+}
+
+// Dir returns the directory, relative to the repository root, that holds a
+// day's solver and fixtures.
+func Dir(year, day int) string {
+	dir := fmt.Sprintf("d%02d", day)
+	if year == HomeYear {
+		return dir
+	}
+	return filepath.Join(strconv.Itoa(year), dir)
+}
+
+// InputPath returns the default puzzle input path for a day, relative to
+// the repository root.
+func InputPath(year, day int) string {
+	return filepath.Join(Dir(year, day), "input.txt")
+}
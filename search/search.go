@@ -0,0 +1,245 @@
+// Package search implements breadth-first, Dijkstra and A* search over
+// implicit graphs described by callbacks, recording every optimal
+// predecessor so callers can recover one or all shortest paths.
+package search
+
+import (
+	"iter"
+
+	"github.com/reckerp/aoc-2024/pq"
+)
+
+// Problem describes an implicit graph whose nodes are search states.
+type Problem[S comparable] struct {
+	// Start lists the states the search begins from, all at distance 0.
+	Start []S
+	// Neighbours yields the states reachable from s in one step.
+	Neighbours func(s S) iter.Seq[S]
+	// Cost returns the non-negative cost of the step from one state to a
+	// neighbour. A nil Cost makes every step cost 1. BFS ignores it.
+	Cost func(from, to S) int
+	// Heuristic estimates the remaining cost from s to the nearest goal.
+	// It must never overestimate. Only AStar uses it.
+	Heuristic func(s S) int
+	// Goal reports whether s is a goal state. With a nil Goal the search
+	// explores every reachable state.
+	Goal func(s S) bool
+}
+
+// Result holds the outcome of a search.
+type Result[S comparable] struct {
+	// Dist maps every reached state to the shortest distance found from
+	// the start. It is exact for every state on an optimal path to a goal.
+	Dist map[S]int
+	// Prev maps each state to all predecessors on an optimal path to it,
+	// forming a DAG rooted at the start states.
+	Prev map[S][]S
+	// Goals lists the goal states reached at the optimal cost, in the
+	// order they were settled.
+	Goals []S
+}
+
+// Found reports whether a goal was reached.
+func (r *Result[S]) Found() bool {
+	return len(r.Goals) > 0
+}
+
+// Cost returns the distance to the nearest goal, or false when no goal was
+// reached.
+func (r *Result[S]) Cost() (int, bool) {
+	if !r.Found() {
+		return 0, false
+	}
+	return r.Dist[r.Goals[0]], true
+}
+
+// Path returns one optimal path from a start state to to, both included,
+// or nil if to was not reached.
+func (r *Result[S]) Path(to S) []S {
+	if _, ok := r.Dist[to]; !ok {
+		return nil
+	}
+	path := []S{to}
+	for prev := r.Prev[to]; len(prev) > 0; prev = r.Prev[prev[0]] {
+		path = append(path, prev[0])
+	}
+	reverse(path)
+	return path
+}
+
+// AllPaths returns every optimal path from a start state to to. The number
+// of paths can grow exponentially; OnPaths is cheaper when only the states
+// involved matter.
+func (r *Result[S]) AllPaths(to S) [][]S {
+	if _, ok := r.Dist[to]; !ok {
+		return nil
+	}
+
+	var paths [][]S
+	var walk func(s S, suffix []S)
+	walk = func(s S, suffix []S) {
+		suffix = append(suffix, s)
+		prev := r.Prev[s]
+		if len(prev) == 0 {
+			path := make([]S, len(suffix))
+			copy(path, suffix)
+			reverse(path)
+			paths = append(paths, path)
+			return
+		}
+		for _, p := range prev {
+			walk(p, suffix)
+		}
+	}
+	walk(to, nil)
+	return paths
+}
+
+// OnPaths returns the set of states that lie on any optimal path to one of
+// targets.
+func (r *Result[S]) OnPaths(targets ...S) map[S]bool {
+	seen := make(map[S]bool)
+	var stack []S
+	for _, t := range targets {
+		if _, ok := r.Dist[t]; ok && !seen[t] {
+			seen[t] = true
+			stack = append(stack, t)
+		}
+	}
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, p := range r.Prev[s] {
+			if !seen[p] {
+				seen[p] = true
+				stack = append(stack, p)
+			}
+		}
+	}
+	return seen
+}
+
+func reverse[S any](s []S) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+func newResult[S comparable](p Problem[S]) *Result[S] {
+	r := &Result[S]{Dist: make(map[S]int), Prev: make(map[S][]S)}
+	for _, s := range p.Start {
+		r.Dist[s] = 0
+	}
+	return r
+}
+
+func isGoal[S comparable](p Problem[S], s S) bool {
+	return p.Goal != nil && p.Goal(s)
+}
+
+// BFS searches a graph whose steps all cost 1, stopping once every goal at
+// the optimal depth has been reached.
+func BFS[S comparable](p Problem[S]) *Result[S] {
+	r := newResult(p)
+	frontier := append([]S(nil), p.Start...)
+
+	for len(frontier) > 0 && !r.Found() {
+		var next []S
+		for _, s := range frontier {
+			if isGoal(p, s) {
+				r.Goals = append(r.Goals, s)
+			}
+		}
+		if r.Found() {
+			break
+		}
+		for _, s := range frontier {
+			d := r.Dist[s] + 1
+			for n := range p.Neighbours(s) {
+				seen, ok := r.Dist[n]
+				switch {
+				case !ok:
+					r.Dist[n] = d
+					r.Prev[n] = []S{s}
+					next = append(next, n)
+				case seen == d:
+					r.Prev[n] = append(r.Prev[n], s)
+				}
+			}
+		}
+		frontier = next
+	}
+	return r
+}
+
+// Dijkstra searches a graph with non-negative step costs.
+func Dijkstra[S comparable](p Problem[S]) *Result[S] {
+	p.Heuristic = nil
+	return AStar(p)
+}
+
+type entry[S any] struct {
+	state    S
+	dist     int
+	priority int
+}
+
+// AStar searches a graph with non-negative step costs, guided by the
+// problem's heuristic. Without a heuristic it behaves as Dijkstra. The
+// heuristic must be consistent for the predecessor DAG to be complete.
+// Zero-cost steps keep it acyclic, but it then misses the predecessors
+// they would give a state already settled.
+func AStar[S comparable](p Problem[S]) *Result[S] {
+	cost := p.Cost
+	if cost == nil {
+		cost = func(S, S) int { return 1 }
+	}
+	h := p.Heuristic
+	if h == nil {
+		h = func(S) int { return 0 }
+	}
+
+	r := newResult(p)
+	q := pq.New(func(a, b entry[S]) bool { return a.priority < b.priority })
+	start := make(map[S]bool)
+	for _, s := range p.Start {
+		start[s] = true
+		q.Push(entry[S]{state: s, priority: h(s)})
+	}
+
+	settled := make(map[S]bool)
+	best := -1
+	for q.Len() > 0 {
+		e := q.Pop()
+		if best >= 0 && e.priority > best {
+			break
+		}
+		if settled[e.state] || e.dist > r.Dist[e.state] {
+			continue
+		}
+		settled[e.state] = true
+
+		if isGoal(p, e.state) {
+			r.Goals = append(r.Goals, e.state)
+			best = e.dist
+			continue
+		}
+
+		for n := range p.Neighbours(e.state) {
+			d := e.dist + cost(e.state, n)
+			seen, ok := r.Dist[n]
+			switch {
+			case !ok || d < seen:
+				r.Dist[n] = d
+				r.Prev[n] = []S{e.state}
+				q.Push(entry[S]{state: n, dist: d, priority: d + h(n)})
+			case d == seen && (d > e.dist || !settled[n] && !start[n]):
+				// A zero-cost step can lead back to a settled or start
+				// state, which must not gain it as a predecessor or the
+				// DAG would have a cycle.
+				r.Prev[n] = append(r.Prev[n], e.state)
+			}
+		}
+	}
+	return r
+}
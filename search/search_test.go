@@ -0,0 +1,164 @@
+package search
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/reckerp/aoc-2024/grid"
+)
+
+const maze = `#########
+#S..#...#
+#.#.#.#.#
+#.#...#.#
+#.#####.#
+#......E#
+#########
+`
+
+func mazeProblem(t *testing.T, text string) (Problem[grid.Point], grid.Point) {
+	t.Helper()
+	g, err := grid.Parse(strings.NewReader(text), "#.SE", grid.Rune)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start, _ := g.Find(grid.Equal('S'))
+	end, _ := g.Find(grid.Equal('E'))
+
+	return Problem[grid.Point]{
+		Start: []grid.Point{start},
+		Neighbours: func(p grid.Point) iter.Seq[grid.Point] {
+			return func(yield func(grid.Point) bool) {
+				for n := range g.Neighbours4(p) {
+					if g.At(n) != '#' && !yield(n) {
+						return
+					}
+				}
+			}
+		},
+		Heuristic: func(p grid.Point) int {
+			d := p.Sub(end)
+			return abs(d.X) + abs(d.Y)
+		},
+		Goal: func(p grid.Point) bool { return p == end },
+	}, end
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func TestAlgorithmsAgree(t *testing.T) {
+	p, end := mazeProblem(t, maze)
+
+	for name, search := range map[string]func(Problem[grid.Point]) *Result[grid.Point]{
+		"BFS":      BFS[grid.Point],
+		"Dijkstra": Dijkstra[grid.Point],
+		"AStar":    AStar[grid.Point],
+	} {
+		r := search(p)
+		cost, ok := r.Cost()
+		if !ok || cost != 10 {
+			t.Errorf("%s: Cost() = %d, %v, want 10, true", name, cost, ok)
+			continue
+		}
+		path := r.Path(end)
+		if len(path) != cost+1 || path[0] != p.Start[0] || path[len(path)-1] != end {
+			t.Errorf("%s: Path() = %v", name, path)
+		}
+	}
+}
+
+func TestAllPaths(t *testing.T) {
+	p, end := mazeProblem(t, "#####\n#S..#\n#...#\n#..E#\n#####\n")
+
+	for name, search := range map[string]func(Problem[grid.Point]) *Result[grid.Point]{
+		"BFS":      BFS[grid.Point],
+		"Dijkstra": Dijkstra[grid.Point],
+		"AStar":    AStar[grid.Point],
+	} {
+		r := search(p)
+		if got := len(r.AllPaths(end)); got != 6 {
+			t.Errorf("%s: %d optimal paths, want 6", name, got)
+		}
+		if got := len(r.OnPaths(end)); got != 9 {
+			t.Errorf("%s: %d states on optimal paths, want 9", name, got)
+		}
+	}
+}
+
+func TestUnreachable(t *testing.T) {
+	p, end := mazeProblem(t, "#####\n#S#E#\n#####\n")
+
+	r := BFS(p)
+	if r.Found() {
+		t.Error("BFS found a goal behind a wall")
+	}
+	if r.Path(end) != nil {
+		t.Error("Path() to an unreached state is not nil")
+	}
+	if r := Dijkstra(p); r.Found() {
+		t.Error("Dijkstra found a goal behind a wall")
+	}
+}
+
+func TestWeightedCosts(t *testing.T) {
+	// a -> b -> d costs 2, a -> c -> d costs 2, a -> d costs 5.
+	edges := map[string]map[string]int{
+		"a": {"b": 1, "c": 1, "d": 5},
+		"b": {"d": 1},
+		"c": {"d": 1},
+	}
+	p := Problem[string]{
+		Start: []string{"a"},
+		Neighbours: func(s string) iter.Seq[string] {
+			return slices.Values(slices.Sorted(maps.Keys(edges[s])))
+		},
+		Cost: func(from, to string) int { return edges[from][to] },
+		Goal: func(s string) bool { return s == "d" },
+	}
+
+	r := Dijkstra(p)
+	if cost, _ := r.Cost(); cost != 2 {
+		t.Errorf("Cost() = %d, want 2", cost)
+	}
+	if got := r.AllPaths("d"); len(got) != 2 {
+		t.Errorf("AllPaths() = %v, want two paths", got)
+	}
+}
+
+func TestZeroCostSteps(t *testing.T) {
+	// a and b are a free step apart both ways, and c is one step from b.
+	edges := map[string]map[string]int{
+		"a": {"b": 0},
+		"b": {"a": 0, "c": 1},
+	}
+	p := Problem[string]{
+		Start: []string{"a"},
+		Neighbours: func(s string) iter.Seq[string] {
+			return slices.Values(slices.Sorted(maps.Keys(edges[s])))
+		},
+		Cost: func(from, to string) int { return edges[from][to] },
+		Goal: func(s string) bool { return s == "c" },
+	}
+
+	r := Dijkstra(p)
+	if got := r.Path("c"); !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("Path() = %v, want [a b c]", got)
+	}
+	if got := len(r.OnPaths("c")); got != 3 {
+		t.Errorf("%d states on optimal paths, want 3", got)
+	}
+
+	p.Start = []string{"a", "b"}
+	r = Dijkstra(p)
+	if got := r.AllPaths("c"); len(got) != 1 || !slices.Equal(got[0], []string{"b", "c"}) {
+		t.Errorf("AllPaths() from both starts = %v, want [[b c]]", got)
+	}
+}
@@ -0,0 +1,56 @@
+package solver
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Params are named numbers a puzzle depends on besides its input, such as
+// the size of a grid or a number of steps. The examples often use smaller
+// values than the real puzzle.
+type Params map[string]int
+
+// ParseParams reads params from a JSON object of integers.
+func ParseParams(data []byte) (Params, error) {
+	var p Params
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("params: %w", err)
+	}
+	return p, nil
+}
+
+// With returns a copy of p in which the values of over replace its own.
+func (p Params) With(over Params) Params {
+	merged := maps.Clone(p)
+	if merged == nil {
+		merged = make(Params, len(over))
+	}
+	maps.Copy(merged, over)
+	return merged
+}
+
+// String lists the params as name=value pairs in name order.
+func (p Params) String() string {
+	pairs := make([]string, 0, len(p))
+	for _, name := range slices.Sorted(maps.Keys(p)) {
+		pairs = append(pairs, fmt.Sprintf("%s=%d", name, p[name]))
+	}
+	return strings.Join(pairs, " ")
+}
+
+// check rejects params that defaults does not list, which are most likely
+// misspelt.
+func (p Params) check(defaults Params) error {
+	for _, name := range slices.Sorted(maps.Keys(p)) {
+		if _, ok := defaults[name]; !ok {
+			if len(defaults) == 0 {
+				return fmt.Errorf("unknown param %q: this day takes none", name)
+			}
+			return fmt.Errorf("unknown param %q: this day takes %s", name, strings.Join(slices.Sorted(maps.Keys(defaults)), ", "))
+		}
+	}
+	return nil
+}
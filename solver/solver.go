@@ -0,0 +1,324 @@
+// Package solver defines the contract every day's puzzle solution implements
+// so the aoc runner can drive all of them in-process.
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/reckerp/aoc-2024/explain"
+	"github.com/reckerp/aoc-2024/parse"
+)
+
+// Solver solves both parts of a single day's puzzle. Parsing is a separate
+// phase so it can be timed, tested and cached independently of the parts.
+type Solver interface {
+	// Parse reads the puzzle input from r and returns the day's model.
+	Parse(r io.Reader) (any, error)
+	// Solve returns the answer for the given part (1 or 2) using a model
+	// previously returned by Parse. It must not modify the model. params
+	// override the day's defaults for the real puzzle; nil keeps them.
+	Solve(part int, input any, params Params) (string, error)
+	// Example returns the published example input for the given part, if
+	// the day embeds one.
+	Example(part int) ([]byte, bool)
+	// Params returns every param the day takes with its value for the real
+	// puzzle or, if example is set, for the embedded examples. Days without
+	// params return nil.
+	Params(example bool) Params
+	// Visualize draws pictures of a model previously returned by Parse,
+	// and of how the parts solve it, with params as for Solve. Days that
+	// draw nothing return ErrNoVisuals.
+	Visualize(input any, params Params) ([]Visual, error)
+	// Reference solves a part like Solve, but with the day's slow and
+	// obviously correct reference solution, to check Solve against. It
+	// returns ErrNoReference for parts without one.
+	Reference(part int, input any, params Params) (string, error)
+	// Explain solves a part like Solve and reports the decisions that led
+	// to the answer to t. Parts that explain nothing solve as with Solve.
+	Explain(part int, input any, params Params, t *explain.Tracer) (string, error)
+}
+
+// Visual is one picture of a day's puzzle: a grid drawn as lines of text
+// with one rune per cell.
+type Visual struct {
+	Title string `json:"title"`
+	Text  string `json:"text"`
+}
+
+// ErrNotImplemented is returned by the parts of a freshly scaffolded day
+// until they are filled in.
+var ErrNotImplemented = errors.New("not implemented")
+
+// ErrNoVisuals is returned by Visualize for days without visuals.
+var ErrNoVisuals = errors.New("no visuals")
+
+// ErrNoReference is returned by Reference for parts without a reference
+// solution. Reference solutions return it too, wrapped, for inputs too
+// large for them to solve in reasonable time.
+var ErrNoReference = errors.New("no reference solution")
+
+// Option configures optional behaviour of a Solver built by New.
+type Option func(*options)
+
+type options struct {
+	examples      [2][]byte
+	exampleParams Params
+	visualize     func(any, Params) ([]Visual, error)
+	reference     [2]func(any, Params) (string, error)
+	explain       [2]func(any, Params, *explain.Tracer) (string, error)
+}
+
+// WithExample embeds the published example input shared by both parts.
+func WithExample(example []byte) Option {
+	return WithExamples(example, example)
+}
+
+// WithExamples embeds separate example inputs for part 1 and part 2.
+func WithExamples(part1, part2 []byte) Option {
+	return func(o *options) {
+		o.examples = [2][]byte{part1, part2}
+	}
+}
+
+// WithExampleParams embeds the params the examples use where they differ
+// from the real puzzle's, as a JSON object of integers. It panics if data
+// is not one, as the data is embedded when the day is built.
+func WithExampleParams(data []byte) Option {
+	p, err := ParseParams(data)
+	if err != nil {
+		panic(err)
+	}
+	return func(o *options) {
+		o.exampleParams = p
+	}
+}
+
+// WithVisuals lets the day draw its model for the dashboard. visualize
+// must accept the model type returned by the day's parser.
+func WithVisuals[T any](visualize func(T, Params) ([]Visual, error)) Option {
+	return func(o *options) {
+		o.visualize = func(input any, params Params) ([]Visual, error) {
+			in, ok := input.(T)
+			if !ok {
+				return nil, fmt.Errorf("unexpected input type %T", input)
+			}
+			return visualize(in, params)
+		}
+	}
+}
+
+// WithReference registers reference solutions of the parts: brute force
+// or otherwise plainly correct code, however slow, that the crosscheck
+// command compares the real parts with. Either may be nil. They must accept
+// the model type returned by the day's parser.
+func WithReference[T, A, B any](part1 func(T, Params) (A, error), part2 func(T, Params) (B, error)) Option {
+	return func(o *options) {
+		if part1 != nil {
+			o.reference[0] = typedPart(part1)
+		}
+		if part2 != nil {
+			o.reference[1] = typedPart(part2)
+		}
+	}
+}
+
+// typedPart adapts a part function to the untyped model.
+func typedPart[T, A any](part func(T, Params) (A, error)) func(any, Params) (string, error) {
+	return func(input any, params Params) (string, error) {
+		in, ok := input.(T)
+		if !ok {
+			return "", fmt.Errorf("unexpected input type %T", input)
+		}
+		return format(part(in, params))
+	}
+}
+
+// WithExplain registers parts that report their decisions to a tracer, for
+// the run command's --explain flag. They must give the same answers as the
+// parts passed to New, which usually call them with a nil tracer. Either may
+// be nil.
+func WithExplain[T, A, B any](part1 func(T, Params, *explain.Tracer) (A, error), part2 func(T, Params, *explain.Tracer) (B, error)) Option {
+	return func(o *options) {
+		if part1 != nil {
+			o.explain[0] = tracedPart(part1)
+		}
+		if part2 != nil {
+			o.explain[1] = tracedPart(part2)
+		}
+	}
+}
+
+// tracedPart adapts a part function taking a tracer to the untyped model.
+func tracedPart[T, A any](part func(T, Params, *explain.Tracer) (A, error)) func(any, Params, *explain.Tracer) (string, error) {
+	return func(input any, params Params, t *explain.Tracer) (string, error) {
+		in, ok := input.(T)
+		if !ok {
+			return "", fmt.Errorf("unexpected input type %T", input)
+		}
+		return format(part(in, params, t))
+	}
+}
+
+type typed[T, A, B any] struct {
+	options
+	defaults Params
+	parse    func(io.Reader) (T, error)
+	part1    func(T, Params) (A, error)
+	part2    func(T, Params) (B, error)
+}
+
+// New builds a Solver from a day's typed parser and part functions.
+func New[T, A, B any](parse func(io.Reader) (T, error), part1 func(T) (A, error), part2 func(T) (B, error), opts ...Option) Solver {
+	return NewWithParams(
+		parse,
+		func(in T, _ Params) (A, error) { return part1(in) },
+		func(in T, _ Params) (B, error) { return part2(in) },
+		nil, opts...)
+}
+
+// NewWithParams builds a Solver for a day whose parts take params besides
+// the model. defaults lists every param with its value for the real puzzle;
+// the parts receive them with any values passed to Solve applied.
+func NewWithParams[T, A, B any](parse func(io.Reader) (T, error), part1 func(T, Params) (A, error), part2 func(T, Params) (B, error), defaults Params, opts ...Option) Solver {
+	s := typed[T, A, B]{defaults: defaults, parse: parse, part1: part1, part2: part2}
+	for _, opt := range opts {
+		opt(&s.options)
+	}
+	return s
+}
+
+func (s typed[T, A, B]) Parse(r io.Reader) (any, error) {
+	return s.parse(r)
+}
+
+func (s typed[T, A, B]) Solve(part int, input any, params Params) (string, error) {
+	in, ok := input.(T)
+	if !ok {
+		return "", fmt.Errorf("unexpected input type %T", input)
+	}
+	if err := params.check(s.defaults); err != nil {
+		return "", err
+	}
+	params = s.defaults.With(params)
+
+	switch part {
+	case 1:
+		return format(s.part1(in, params))
+	case 2:
+		return format(s.part2(in, params))
+	}
+	return "", fmt.Errorf("invalid part: %d", part)
+}
+
+func (s typed[T, A, B]) Example(part int) ([]byte, bool) {
+	if part < 1 || part > 2 || s.examples[part-1] == nil {
+		return nil, false
+	}
+	return s.examples[part-1], true
+}
+
+func (s typed[T, A, B]) Params(example bool) Params {
+	if s.defaults == nil {
+		return nil
+	}
+	if example {
+		return s.defaults.With(s.exampleParams)
+	}
+	return s.defaults.With(nil)
+}
+
+func (s typed[T, A, B]) Visualize(input any, params Params) ([]Visual, error) {
+	if s.visualize == nil {
+		return nil, ErrNoVisuals
+	}
+	if err := params.check(s.defaults); err != nil {
+		return nil, err
+	}
+	return s.visualize(input, s.defaults.With(params))
+}
+
+func (s typed[T, A, B]) Reference(part int, input any, params Params) (string, error) {
+	if part < 1 || part > 2 {
+		return "", fmt.Errorf("invalid part: %d", part)
+	}
+	reference := s.reference[part-1]
+	if reference == nil {
+		return "", ErrNoReference
+	}
+	if err := params.check(s.defaults); err != nil {
+		return "", err
+	}
+	return reference(input, s.defaults.With(params))
+}
+
+func (s typed[T, A, B]) Explain(part int, input any, params Params, t *explain.Tracer) (string, error) {
+	if part < 1 || part > 2 || s.explain[part-1] == nil {
+		return s.Solve(part, input, params)
+	}
+	if err := params.check(s.defaults); err != nil {
+		return "", err
+	}
+	return s.explain[part-1](input, s.defaults.With(params), t)
+}
+
+func format[A any](answer A, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprint(answer), nil
+}
+
+// Explaining returns s with Solve replaced by Explain, reporting the events
+// of each of the day's parts to sink. Every part ends with an "answer"
+// event, so days that explain nothing else still show up in the trace.
+func Explaining(s Solver, day int, sink explain.Sink) Solver {
+	return explaining{Solver: s, day: day, sink: sink}
+}
+
+type explaining struct {
+	Solver
+	day  int
+	sink explain.Sink
+}
+
+func (s explaining) Solve(part int, input any, params Params) (string, error) {
+	t := explain.New(s.sink, s.day, part)
+	answer, err := s.Explain(part, input, params, t)
+	if err != nil {
+		return "", err
+	}
+	t.Event("answer", "value", answer)
+	if err := t.Err(); err != nil {
+		return "", fmt.Errorf("explain: %w", err)
+	}
+	return answer, nil
+}
+
+// Run parses the input read from r and solves the given part with the
+// day's default params.
+func Run(s Solver, part int, r io.Reader) (string, error) {
+	input, err := s.Parse(r)
+	if err != nil {
+		return "", err
+	}
+	return s.Solve(part, input, nil)
+}
+
+// ParseFile parses the puzzle input stored at path. Parse errors report
+// path as their file.
+func ParseFile(s Solver, path string) (any, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	input, err := s.Parse(file)
+	if err != nil {
+		return nil, parse.InFile(err, path)
+	}
+	return input, nil
+}